@@ -10,7 +10,6 @@ import (
 	"github.com/nspcc-dev/neofs-api-go/v2/acl"
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
-	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
@@ -211,12 +210,12 @@ func (b Token) OwnerID() user.ID {
 	return id
 }
 
-// Sign signs bearer token. This method should be invoked with the private
-// key of container owner to allow overriding extended ACL table of the container
+// Sign signs bearer token. This method should be invoked with the signer
+// of container owner to allow overriding extended ACL table of the container
 // included in this token.
 //
 // See also Signature.
-func (b *Token) Sign(key ecdsa.PrivateKey) error {
+func (b *Token) Sign(signer neofscrypto.Signer) error {
 	err := sanityCheck(b)
 	if err != nil {
 		return err
@@ -231,7 +230,7 @@ func (b *Token) Sign(key ecdsa.PrivateKey) error {
 
 	var sig neofscrypto.Signature
 
-	err = sig.Calculate(neofsecdsa.Signer(key), data)
+	err = sig.Calculate(signer, data)
 	if err != nil {
 		return fmt.Errorf("calculate signature: %w", err)
 	}
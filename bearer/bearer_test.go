@@ -6,6 +6,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	tokentest "github.com/nspcc-dev/neofs-sdk-go/bearer/test"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/stretchr/testify/require"
@@ -27,7 +28,23 @@ func TestBearerToken_Issuer(t *testing.T) {
 		user.IDFromKey(&id, p.PrivateKey.PublicKey)
 
 		bearerToken.SetEACLTable(*eacl.NewTable())
-		require.NoError(t, bearerToken.Sign(p.PrivateKey))
+		require.NoError(t, bearerToken.Sign(neofsecdsa.Signer(p.PrivateKey)))
+		issuer, ok := bearerToken.Issuer()
+		require.True(t, ok)
+		require.True(t, id.Equals(issuer))
+	})
+
+	t.Run("Wallet Connect", func(t *testing.T) {
+		p, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		var id user.ID
+		user.IDFromKey(&id, p.PrivateKey.PublicKey)
+
+		bearerToken.SetEACLTable(*eacl.NewTable())
+		require.NoError(t, bearerToken.Sign(neofsecdsa.SignerWalletConnect(p.PrivateKey)))
+		require.NoError(t, bearerToken.VerifySignature())
+
 		issuer, ok := bearerToken.Issuer()
 		require.True(t, ok)
 		require.True(t, id.Equals(issuer))
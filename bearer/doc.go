@@ -15,7 +15,7 @@ the user that will attach token to its requests.
 	bearerToken.SetOwner(ownerID)
 
 Bearer token must be signed by owner of the container.
-	err := bearerToken.Sign(privateKey)
+	err := bearerToken.Sign(signer)
 
 Provide signed token in JSON or binary format to the request sender. Request
 sender can attach this bearer token to the object service requests:
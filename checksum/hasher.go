@@ -0,0 +1,105 @@
+package checksum
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// Hasher calculates Checksum of the data streamed into it. Unlike Calculate,
+// it does not require the data to be held in memory.
+//
+// Hasher implements hash.Hash, so it can be used as io.Writer.
+//
+// Instances must be created using NewHasher.
+type Hasher struct {
+	hash.Hash
+
+	typ Type
+}
+
+// NewHasher returns Hasher of the given checksum type.
+//
+// Panics if the type is not one of the:
+//  * SHA256;
+//  * TZ.
+func NewHasher(t Type) *Hasher {
+	var h hash.Hash
+
+	switch t {
+	case SHA256:
+		h = sha256.New()
+	case TZ:
+		h = tz.New()
+	default:
+		panic(fmt.Sprintf("unsupported checksum type %v", t))
+	}
+
+	return &Hasher{
+		Hash: h,
+		typ:  t,
+	}
+}
+
+// Type returns type of the calculated checksum.
+func (x *Hasher) Type() Type {
+	return x.typ
+}
+
+// Checksum returns checksum of the data written so far.
+// It does not change the underlying hash state, so the data
+// can be written further.
+func (x *Hasher) Checksum() Checksum {
+	var res Checksum
+
+	switch x.typ {
+	case SHA256:
+		var v [sha256.Size]byte
+		copy(v[:], x.Sum(nil))
+		res.SetSHA256(v)
+	case TZ:
+		var v [tz.Size]byte
+		copy(v[:], x.Sum(nil))
+		res.SetTillichZemor(v)
+	}
+
+	return res
+}
+
+// ConcatTZ calculates Tillich-Zémor checksum of the data concatenated from
+// the parts with the given checksums, and writes it to c. Parts must be
+// ordered as in the resulting data. Checksum must not be nil.
+//
+// Returns an error if any of the parts is not a TZ checksum.
+//
+// See also Calculate.
+func ConcatTZ(c *Checksum, parts ...Checksum) error {
+	if len(parts) == 0 {
+		return errors.New("no parts to concatenate")
+	}
+
+	sums := make([][]byte, len(parts))
+
+	for i := range parts {
+		if parts[i].Type() != TZ {
+			return fmt.Errorf("part #%d: unexpected checksum type %v", i, parts[i].Type())
+		}
+
+		sums[i] = parts[i].Value()
+	}
+
+	sum, err := tz.Concat(sums)
+	if err != nil {
+		return fmt.Errorf("concatenate homomorphic hashes: %w", err)
+	}
+
+	var v [tz.Size]byte
+	copy(v[:], sum)
+
+	c.SetTillichZemor(v)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package checksum
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasher(t *testing.T) {
+	data := make([]byte, 1<<10)
+	_, _ = rand.Read(data)
+
+	for _, typ := range []Type{SHA256, TZ} {
+		h := NewHasher(typ)
+		require.Equal(t, typ, h.Type())
+
+		for i := 0; i < len(data); i += 100 {
+			end := i + 100
+			if end > len(data) {
+				end = len(data)
+			}
+
+			_, err := h.Write(data[i:end])
+			require.NoError(t, err)
+		}
+
+		var expected Checksum
+		Calculate(&expected, typ, data)
+
+		require.Equal(t, expected, h.Checksum())
+	}
+
+	require.Panics(t, func() { NewHasher(Unknown) })
+}
+
+func TestConcatTZ(t *testing.T) {
+	data := make([]byte, 1<<10)
+	_, _ = rand.Read(data)
+
+	var expected, actual Checksum
+	Calculate(&expected, TZ, data)
+
+	parts := make([]Checksum, 4)
+	for i := range parts {
+		Calculate(&parts[i], TZ, data[i*256:(i+1)*256])
+	}
+
+	require.NoError(t, ConcatTZ(&actual, parts...))
+	require.Equal(t, expected, actual)
+
+	require.Error(t, ConcatTZ(&actual))
+
+	Calculate(&parts[0], SHA256, data[:256])
+	require.Error(t, ConcatTZ(&actual, parts...))
+}
@@ -86,10 +86,12 @@ func (c *Client) BalanceGet(ctx context.Context, prm PrmBalanceGet) (*ResBalance
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "BalanceGet"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.Balance(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.Balance(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2accounting.BalanceResponse)
@@ -8,6 +8,8 @@ import (
 	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
 	"github.com/nspcc-dev/neofs-api-go/v2/rpc"
 	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 )
 
 // Client represents virtual connection to the NeoFS network to communicate
@@ -43,6 +45,10 @@ import (
 type Client struct {
 	prm PrmInit
 
+	endpoint string
+
+	serverKey []byte
+
 	c client.Client
 }
 
@@ -80,6 +86,13 @@ func (c *Client) Dial(prm PrmDial) error {
 		prm.timeoutDial = 5 * time.Second
 	}
 
+	if c.prm.respVerification == ResponseVerificationServerKey && len(prm.serverKey) == 0 {
+		panic("missing server key for the response verification")
+	}
+
+	c.endpoint = prm.endpoint
+	c.serverKey = prm.serverKey
+
 	c.c = *client.New(append(
 		client.WithNetworkURIAddress(prm.endpoint, prm.tlsConfig),
 		client.WithDialTimeout(prm.timeoutDial),
@@ -110,19 +123,38 @@ func (c *Client) Close() error {
 type PrmInit struct {
 	resolveNeoFSErrors bool
 
-	key ecdsa.PrivateKey
+	signer neofscrypto.Signer
 
 	cbRespInfo func(ResponseMetaInfo) error
 
 	netMagic uint64
+
+	interceptors []Interceptor
+
+	tracer Tracer
+
+	respVerification ResponseVerification
 }
 
 // SetDefaultPrivateKey sets Client private key to be used for the protocol
-// communication by default.
+// communication by default. The requests are signed using ECDSA with SHA-512
+// hashing (see neofsecdsa.Signer).
 //
 // Required for operations without custom key parametrization (see corresponding Prm* docs).
+//
+// See also SetDefaultSigner.
 func (x *PrmInit) SetDefaultPrivateKey(key ecdsa.PrivateKey) {
-	x.key = key
+	x.SetDefaultSigner(neofsecdsa.Signer(key))
+}
+
+// SetDefaultSigner sets Client signer to be used for the protocol
+// communication by default. Any signature scheme registered using
+// neofscrypto.RegisterScheme can be used.
+//
+// Required for operations without custom signer parametrization (see
+// corresponding Prm* docs).
+func (x *PrmInit) SetDefaultSigner(signer neofscrypto.Signer) {
+	x.signer = signer
 }
 
 // ResolveNeoFSFailures makes the Client to resolve failure statuses of the
@@ -139,6 +171,32 @@ func (x *PrmInit) SetResponseInfoCallback(f func(ResponseMetaInfo) error) {
 	x.cbRespInfo = f
 }
 
+// AddInterceptor adds Interceptor of the message exchange with the server.
+// Interceptors are called in the order they are added: the first one is the
+// outermost.
+func (x *PrmInit) AddInterceptor(f Interceptor) {
+	x.interceptors = append(x.interceptors, f)
+}
+
+// SetTracer sets Tracer to open the span of each operation. The trace ID of
+// the span is sent to the server in the XHeaderTraceID X-header. Nil (default)
+// means no tracing.
+//
+// Spans of the stream operations (e.g. ObjectGetInit) are finished when the
+// stream is closed.
+func (x *PrmInit) SetTracer(t Tracer) {
+	x.tracer = t
+}
+
+// SetResponseVerification sets the way the Client verifies the server
+// responses. ResponseVerificationStrict is used by default.
+//
+// ResponseVerificationServerKey requires the expected server key to be
+// specified on Dial (see PrmDial.SetServerKey).
+func (x *PrmInit) SetResponseVerification(v ResponseVerification) {
+	x.respVerification = v
+}
+
 // PrmDial groups connection parameters for the Client.
 //
 // See also Dial.
@@ -149,6 +207,8 @@ type PrmDial struct {
 
 	timeoutDialSet bool
 	timeoutDial    time.Duration
+
+	serverKey []byte
 }
 
 // SetServerURI sets server URI in the NeoFS network.
@@ -180,3 +240,12 @@ func (x *PrmDial) SetTimeout(timeout time.Duration) {
 	x.timeoutDialSet = true
 	x.timeoutDial = timeout
 }
+
+// SetServerKey sets expected binary-encoded public key of the server, e.g.
+// from the network map (see netmap.NodeInfo.PublicKey). Required if the Client
+// is initialized with ResponseVerificationServerKey.
+//
+// Key must not be mutated until the Client is closed.
+func (x *PrmDial) SetServerKey(key []byte) {
+	x.serverKey = key
+}
@@ -1,14 +1,17 @@
 package client
 
 import (
-	"crypto/ecdsa"
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
 	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
-	"github.com/nspcc-dev/neofs-api-go/v2/signature"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
 )
 
@@ -82,11 +85,17 @@ type contextCall struct {
 	// received response
 	resp responseV2
 
+	// set if at least one response has been received
+	received bool
+
+	// span of the traced call
+	span Span
+
 	// ==================================================
 	// shared parameters which are set uniformly on all calls
 
-	// request signing key
-	key ecdsa.PrivateKey
+	// request signer
+	signer neofscrypto.Signer
 
 	// callback prior to processing the response by the client
 	callbackResp func(ResponseMetaInfo) error
@@ -97,12 +106,37 @@ type contextCall struct {
 	// NeoFS network magic
 	netMagic uint64
 
+	// interceptors of the message exchange in the order of registration
+	interceptors []Interceptor
+
+	// tracer of the calls (optional)
+	tracer Tracer
+
+	// server address
+	endpoint string
+
+	// if set, responses are not verified
+	skipVerification bool
+
+	// expected public key of the server (optional)
+	serverKey []byte
+
 	// Meta parameters
 	meta prmCommonMeta
 
 	// ==================================================
 	// custom call parameters
 
+	// context of the call passed to the interceptors
+	ctx context.Context
+
+	// name of the Client method performing the call
+	method string
+
+	// container and object referenced by the call, used for tracing (optional)
+	cnr *cid.ID
+	obj *oid.ID
+
 	// structure of the call result
 	statusRes resCommon
 
@@ -150,6 +184,8 @@ func (x contextCall) prepareRequest() {
 	meta.SetNetworkMagic(x.netMagic)
 
 	x.meta.writeToMetaHeader(meta)
+
+	x.writeTraceID(meta)
 }
 
 // prepares, signs and writes the request. Result means success.
@@ -157,10 +193,21 @@ func (x contextCall) prepareRequest() {
 func (x *contextCall) writeRequest() bool {
 	x.prepareRequest()
 
-	x.req.SetVerificationHeader(nil)
+	info := CallInfo{reqMeta: x.req.GetMetaHeader()}
+
+	return x.intercept(&info, x.sendRequest)
+}
+
+// signs and writes the prepared request. Result means success.
+// If failed, contextCall.err contains the reason.
+func (x *contextCall) sendRequest() bool {
+	if x.signer == nil {
+		x.err = errors.New("missing request signer")
+		return false
+	}
 
 	// sign the request
-	x.err = signature.SignServiceMessage(&x.key, x.req)
+	x.err = signRequest(x.signer, x.req)
 	if x.err != nil {
 		x.err = fmt.Errorf("sign request: %w", x.err)
 		return false
@@ -179,8 +226,8 @@ func (x *contextCall) writeRequest() bool {
 // (in both cases returns false).
 //
 // Actions:
-//  * verify signature (internal);
 //  * call response callback (internal);
+//  * verify signatures (optional, see ResponseVerification);
 //  * unwrap status error (optional).
 func (x *contextCall) processResponse() bool {
 	// call response callback if set
@@ -198,11 +245,13 @@ func (x *contextCall) processResponse() bool {
 	// note that we call response callback before signature check since it is expected more lightweight
 	// while verification needs marshaling
 
-	// verify response signature
-	x.err = signature.VerifyServiceMessage(x.resp)
-	if x.err != nil {
-		x.err = fmt.Errorf("invalid response signature: %w", x.err)
-		return false
+	// verify response signatures
+	if !x.skipVerification {
+		x.err = verifyResponse(x.resp, x.serverKey)
+		if x.err != nil {
+			x.err = fmt.Errorf("invalid response signature: %w", x.err)
+			return false
+		}
 	}
 
 	// get result status
@@ -224,6 +273,16 @@ func (x *contextCall) processResponse() bool {
 // reads response (if rResp is set) and processes it. Result means success.
 // If failed, contextCall.err contains the reason.
 func (x *contextCall) readResponse() bool {
+	var info CallInfo
+
+	return x.intercept(&info, func() bool {
+		return x.receiveResponse(&info)
+	})
+}
+
+// reads response (if rResp is set), writes it to info and processes it.
+// Result means success. If failed, contextCall.err contains the reason.
+func (x *contextCall) receiveResponse(info *CallInfo) bool {
 	if x.rResp != nil {
 		x.err = x.rResp()
 		if x.err != nil {
@@ -232,6 +291,9 @@ func (x *contextCall) readResponse() bool {
 		}
 	}
 
+	x.received = true
+	info.resp = x.resp
+
 	return x.processResponse()
 }
 
@@ -257,6 +319,9 @@ func (x *contextCall) close() bool {
 // goes through all stages of sending a request and processing a response. Returns true if successful.
 // If failed, contextCall.err contains the reason.
 func (x *contextCall) processCall() bool {
+	x.startSpan()
+	defer func() { x.endSpan(x.err) }()
+
 	// set request writer
 	x.wReq = func() error {
 		var err error
@@ -264,14 +329,14 @@ func (x *contextCall) processCall() bool {
 		return err
 	}
 
-	// write request
-	ok := x.writeRequest()
-	if !ok {
-		return false
-	}
+	x.prepareRequest()
 
-	// read response
-	ok = x.readResponse()
+	info := CallInfo{reqMeta: x.req.GetMetaHeader()}
+
+	// write request and read response
+	ok := x.intercept(&info, func() bool {
+		return x.sendRequest() && x.receiveResponse(&info)
+	})
 	if !ok {
 		return false
 	}
@@ -285,17 +350,66 @@ func (x *contextCall) processCall() bool {
 	return x.err == nil
 }
 
+// runs f through the interceptors of the call in the order of registration.
+// Result of f means success. Result means success of the whole chain.
+// If failed, contextCall.err contains the reason.
+func (x *contextCall) intercept(info *CallInfo, f func() bool) bool {
+	if len(x.interceptors) == 0 {
+		return f()
+	}
+
+	info.method = x.method
+
+	var ok bool
+
+	next := func() error {
+		ok = f()
+		if !ok {
+			return x.err
+		}
+
+		return nil
+	}
+
+	for i := len(x.interceptors) - 1; i >= 0; i-- {
+		interceptor, nextInner := x.interceptors[i], next
+
+		next = func() error {
+			return interceptor(x.ctx, info, nextInner)
+		}
+	}
+
+	if err := next(); err != nil {
+		x.err = err
+		return false
+	}
+
+	if !ok && x.err == nil {
+		x.err = errors.New("message exchange is interrupted by the interceptor")
+	}
+
+	return ok
+}
+
 // initializes static cross-call parameters inherited from client.
 func (c *Client) initCallContext(ctx *contextCall) {
-	ctx.key = c.prm.key
-	c.initCallContextWithoutKey(ctx)
+	ctx.signer = c.prm.signer
+	c.initCallContextWithoutSigner(ctx)
 }
 
-// initializes static cross-call parameters inherited from client except private key.
-func (c *Client) initCallContextWithoutKey(ctx *contextCall) {
+// initializes static cross-call parameters inherited from client except signer.
+func (c *Client) initCallContextWithoutSigner(ctx *contextCall) {
 	ctx.resolveAPIFailures = c.prm.resolveNeoFSErrors
 	ctx.callbackResp = c.prm.cbRespInfo
 	ctx.netMagic = c.prm.netMagic
+	ctx.interceptors = c.prm.interceptors
+	ctx.tracer = c.prm.tracer
+	ctx.endpoint = c.endpoint
+	ctx.skipVerification = c.prm.respVerification == ResponseVerificationSkip
+
+	if c.prm.respVerification == ResponseVerificationServerKey {
+		ctx.serverKey = c.serverKey
+	}
 }
 
 // ExecRaw executes f with underlying github.com/nspcc-dev/neofs-api-go/v2/rpc/client.Client
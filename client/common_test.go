@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	signatureV2 "github.com/nspcc-dev/neofs-api-go/v2/signature"
+	v2status "github.com/nspcc-dev/neofs-api-go/v2/status"
+	"github.com/stretchr/testify/require"
+)
+
+// testBalanceCall returns unary call of the Client initialized with prm.
+// The server responds with the internal error status.
+func testBalanceCall(t *testing.T, prm PrmInit) (*contextCall, *v2accounting.BalanceRequest) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	prm.SetDefaultPrivateKey(k.PrivateKey)
+
+	var req v2accounting.BalanceRequest
+	req.SetBody(new(v2accounting.BalanceRequestBody))
+
+	var c Client
+	c.Init(prm)
+	c.endpoint = "localhost:8080"
+
+	cc := new(contextCall)
+	c.initCallContext(cc)
+	cc.ctx = context.Background()
+	cc.method = "BalanceGet"
+	cc.req = &req
+	cc.statusRes = new(ResBalanceGet)
+	cc.call = func() (responseV2, error) {
+		require.NoError(t, signatureV2.VerifyServiceMessage(&req))
+
+		var st v2status.Status
+		st.SetCode(1024) // internal server error
+
+		var meta v2session.ResponseMetaHeader
+		meta.SetStatus(&st)
+
+		var resp v2accounting.BalanceResponse
+		resp.SetBody(new(v2accounting.BalanceResponseBody))
+		resp.SetMetaHeader(&meta)
+
+		require.NoError(t, signatureV2.SignServiceMessage(&k.PrivateKey, &resp))
+
+		return &resp, nil
+	}
+
+	return cc, &req
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	v2container "github.com/nspcc-dev/neofs-api-go/v2/container"
@@ -89,9 +90,14 @@ func (c *Client) ContainerPut(ctx context.Context, prm PrmContainerPut) (*ResCon
 		return nil, fmt.Errorf("marshal container: %w", err)
 	}
 
+	signer, err := c.containerSigner()
+	if err != nil {
+		return nil, err
+	}
+
 	var sig neofscrypto.Signature
 
-	err = sig.Calculate(neofsecdsa.SignerRFC6979(c.prm.key), data)
+	err = sig.Calculate(signer, data)
 	if err != nil {
 		return nil, fmt.Errorf("calculate signature: %w", err)
 	}
@@ -130,10 +136,12 @@ func (c *Client) ContainerPut(ctx context.Context, prm PrmContainerPut) (*ResCon
 	)
 
 	c.initCallContext(&cc)
+	cc.ctx = ctx
+	cc.method = "ContainerPut"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.PutContainer(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.PutContainer(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2container.PutResponse)
@@ -148,6 +156,7 @@ func (c *Client) ContainerPut(ctx context.Context, prm PrmContainerPut) (*ResCon
 		}
 
 		res.setID(cID)
+		cc.cnr = cID
 	}
 
 	// process call
@@ -234,10 +243,13 @@ func (c *Client) ContainerGet(ctx context.Context, prm PrmContainerGet) (*ResCon
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "ContainerGet"
+	cc.cnr = &prm.id
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.GetContainer(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.GetContainer(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2container.GetResponse)
@@ -353,10 +365,12 @@ func (c *Client) ContainerList(ctx context.Context, prm PrmContainerList) (*ResC
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "ContainerList"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.ListContainers(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.ListContainers(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2container.ListResponse)
@@ -450,9 +464,14 @@ func (c *Client) ContainerDelete(ctx context.Context, prm PrmContainerDelete) (*
 	// don't get confused with stable marshaled protobuf container.ID structure
 	data := cidV2.GetValue()
 
+	signer, err := c.containerSigner()
+	if err != nil {
+		return nil, err
+	}
+
 	var sig neofscrypto.Signature
 
-	err := sig.Calculate(neofsecdsa.SignerRFC6979(c.prm.key), data)
+	err = sig.Calculate(signer, data)
 	if err != nil {
 		return nil, fmt.Errorf("calculate signature: %w", err)
 	}
@@ -491,10 +510,13 @@ func (c *Client) ContainerDelete(ctx context.Context, prm PrmContainerDelete) (*
 	)
 
 	c.initCallContext(&cc)
+	cc.ctx = ctx
+	cc.method = "ContainerDelete"
+	cc.cnr = &prm.id
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.DeleteContainer(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.DeleteContainer(&c.c, &req, client.WithContext(cc.ctx))
 	}
 
 	// process call
@@ -582,10 +604,13 @@ func (c *Client) ContainerEACL(ctx context.Context, prm PrmContainerEACL) (*ResC
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "ContainerEACL"
+	cc.cnr = &prm.id
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.GetEACL(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.GetEACL(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2container.GetExtendedACLResponse)
@@ -680,9 +705,14 @@ func (c *Client) ContainerSetEACL(ctx context.Context, prm PrmContainerSetEACL)
 		return nil, fmt.Errorf("marshal eACL: %w", err)
 	}
 
+	signer, err := c.containerSigner()
+	if err != nil {
+		return nil, err
+	}
+
 	var sig neofscrypto.Signature
 
-	err = sig.Calculate(neofsecdsa.SignerRFC6979(c.prm.key), data)
+	err = sig.Calculate(signer, data)
 	if err != nil {
 		return nil, fmt.Errorf("calculate signature: %w", err)
 	}
@@ -721,10 +751,15 @@ func (c *Client) ContainerSetEACL(ctx context.Context, prm PrmContainerSetEACL)
 	)
 
 	c.initCallContext(&cc)
+	cc.ctx = ctx
+	cc.method = "ContainerSetEACL"
+	if id, ok := prm.table.CID(); ok {
+		cc.cnr = &id
+	}
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.SetEACL(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.SetEACL(&c.c, &req, client.WithContext(cc.ctx))
 	}
 
 	// process call
@@ -806,10 +841,12 @@ func (c *Client) ContainerAnnounceUsedSpace(ctx context.Context, prm PrmAnnounce
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "ContainerAnnounceUsedSpace"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.AnnounceUsedSpace(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.AnnounceUsedSpace(&c.c, &req, client.WithContext(cc.ctx))
 	}
 
 	// process call
@@ -819,3 +856,22 @@ func (c *Client) ContainerAnnounceUsedSpace(ctx context.Context, prm PrmAnnounce
 
 	return &res, nil
 }
+
+// containerSigner returns signer of the container data. Container contract
+// accepts deterministic ECDSA signatures (RFC 6979) only, so the Client signer
+// must either be neofsecdsa.Signer or use neofscrypto.ECDSA_DETERMINISTIC_SHA256
+// scheme.
+func (c *Client) containerSigner() (neofscrypto.Signer, error) {
+	switch signer := c.prm.signer.(type) {
+	case nil:
+		return nil, errors.New("missing signer")
+	case neofsecdsa.Signer:
+		return neofsecdsa.SignerRFC6979(signer), nil
+	default:
+		if signer.Scheme() != neofscrypto.ECDSA_DETERMINISTIC_SHA256 {
+			return nil, fmt.Errorf("container data can't be signed using %v scheme", signer.Scheme())
+		}
+
+		return signer, nil
+	}
+}
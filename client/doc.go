@@ -61,6 +61,25 @@ Close the connection:
 	err := c.Close()
 	// ...
 
+Intercept the message exchange with the server (e.g. for logging):
+	var prm client.PrmInit
+	prm.AddInterceptor(func(ctx context.Context, info *client.CallInfo, next func() error) error {
+		start := time.Now()
+		err := next()
+		log.Printf("%s: %v, status %v, took %v", info.Method(), err, info.Status(), time.Since(start))
+		return err
+	})
+	// ...
+
+Trace the operations (e.g. using OpenTelemetry adapter of the client.Tracer):
+	var prm client.PrmInit
+	prm.SetTracer(tracer)
+	// ...
+
+	// span of the operation is a child of the span carried by ctx
+	res, err := c.ContainerPut(ctx, prmPut)
+	// ...
+
 Note that it's not allowed to override Client behaviour directly: the parameters
 for the all operations are write-only and the results of the all operations are
 read-only. To be able to override client behavior (e.g. for tests), abstract it
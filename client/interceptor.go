@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+// Interceptor is a middleware of the message exchange between the Client and
+// the server. It is called for each unary RPC, and for each message sent or
+// received within the stream RPC (e.g. for each chunk of the object payload).
+//
+// Interceptor must call next to proceed with the exchange and return its error
+// (possibly wrapped). Interceptor may abort the exchange by returning an error
+// without calling next. The error is returned from the Client method.
+//
+// Context is the one passed to the Client method.
+//
+// See also PrmInit.AddInterceptor.
+type Interceptor func(ctx context.Context, info *CallInfo, next func() error) error
+
+// CallInfo describes the message exchange passed to the Interceptor.
+type CallInfo struct {
+	method string
+
+	reqMeta *v2session.RequestMetaHeader
+
+	resp responseV2
+}
+
+// Method returns name of the Client method performing the call, e.g.
+// ContainerPut or ObjectGetInit.
+func (x CallInfo) Method() string {
+	return x.method
+}
+
+// RequestMetaHeader returns meta header of the request being sent. Returns nil
+// if only the stream message is received.
+//
+// The header may be modified before calling next: the request is signed after
+// all the interceptors. Note that messages of the stream may share the header.
+func (x CallInfo) RequestMetaHeader() *v2session.RequestMetaHeader {
+	return x.reqMeta
+}
+
+// ResponseMetaHeader returns meta header of the received response. Returns nil
+// before next is called, if only the stream message is sent, or if the response
+// has not been received.
+//
+// Result must not be mutated.
+func (x CallInfo) ResponseMetaHeader() *v2session.ResponseMetaHeader {
+	if x.resp == nil {
+		return nil
+	}
+
+	return x.resp.GetMetaHeader()
+}
+
+// Status returns status of the received response. Returns nil if the response
+// has not been received (see ResponseMetaHeader).
+func (x CallInfo) Status() apistatus.Status {
+	if x.resp == nil {
+		return nil
+	}
+
+	return apistatus.FromStatusV2(x.resp.GetMetaHeader().GetStatus())
+}
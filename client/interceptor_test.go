@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextCall_Interceptors(t *testing.T) {
+	newCall := func(t *testing.T, interceptors ...Interceptor) (*contextCall, *v2accounting.BalanceRequest) {
+		var prm PrmInit
+
+		for i := range interceptors {
+			prm.AddInterceptor(interceptors[i])
+		}
+
+		return testBalanceCall(t, prm)
+	}
+
+	t.Run("order", func(t *testing.T) {
+		var calls []string
+
+		interceptor := func(name string) Interceptor {
+			return func(ctx context.Context, info *CallInfo, next func() error) error {
+				require.NotNil(t, ctx)
+				require.Equal(t, "BalanceGet", info.Method())
+				require.NotNil(t, info.RequestMetaHeader())
+				require.Nil(t, info.ResponseMetaHeader())
+
+				calls = append(calls, name+" before")
+
+				err := next()
+
+				require.NotNil(t, info.ResponseMetaHeader())
+				require.IsType(t, new(apistatus.ServerInternal), info.Status())
+
+				calls = append(calls, name+" after")
+
+				return err
+			}
+		}
+
+		cc, _ := newCall(t, interceptor("first"), interceptor("second"))
+
+		require.True(t, cc.processCall())
+		require.Equal(t, []string{"first before", "second before", "second after", "first after"}, calls)
+	})
+
+	t.Run("request mutation", func(t *testing.T) {
+		var xHdr v2session.XHeader
+		xHdr.SetKey("key")
+		xHdr.SetValue("value")
+
+		cc, req := newCall(t, func(_ context.Context, info *CallInfo, next func() error) error {
+			info.RequestMetaHeader().SetXHeaders([]v2session.XHeader{xHdr})
+			return next()
+		})
+
+		require.True(t, cc.processCall())
+		require.Equal(t, []v2session.XHeader{xHdr}, req.GetMetaHeader().GetXHeaders())
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		errAbort := errors.New("abort")
+
+		cc, _ := newCall(t, func(context.Context, *CallInfo, func() error) error {
+			return errAbort
+		})
+		cc.call = func() (responseV2, error) {
+			t.Fatal("request must not be sent")
+			return nil, nil
+		}
+
+		require.False(t, cc.processCall())
+		require.ErrorIs(t, cc.err, errAbort)
+
+		cc, _ = newCall(t, func(context.Context, *CallInfo, func() error) error {
+			return nil
+		})
+		cc.call = func() (responseV2, error) {
+			t.Fatal("request must not be sent")
+			return nil, nil
+		}
+
+		require.False(t, cc.processCall())
+		require.Error(t, cc.err)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		var errNext error
+
+		cc, _ := newCall(t, func(_ context.Context, _ *CallInfo, next func() error) error {
+			errNext = next()
+			return errNext
+		})
+		cc.resolveAPIFailures = true
+
+		require.False(t, cc.processCall())
+		require.Error(t, errNext)
+		require.Equal(t, errNext, cc.err)
+	})
+}
+
+func TestObjectListReader_Interceptors(t *testing.T) {
+	r, setID := testListReaderResponse(t)
+	setID(nil)
+
+	var n int
+
+	r.ctxCall.method = "ObjectSearchInit"
+	r.ctxCall.interceptors = []Interceptor{func(_ context.Context, info *CallInfo, next func() error) error {
+		require.Equal(t, "ObjectSearchInit", info.Method())
+		require.Nil(t, info.RequestMetaHeader())
+
+		n++
+
+		return next()
+	}}
+
+	responses := 3
+	r.ctxCall.rResp = func() error {
+		if responses == 0 {
+			return errors.New("end of stream")
+		}
+
+		responses--
+
+		return nil
+	}
+
+	buf := make([]oid.ID, 1)
+	_, ok := r.Read(buf)
+	require.False(t, ok)
+	require.Equal(t, 4, n, "interceptor must be called for each message")
+}
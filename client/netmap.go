@@ -81,10 +81,12 @@ func (c *Client) EndpointInfo(ctx context.Context, prm PrmEndpointInfo) (*ResEnd
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "EndpointInfo"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.LocalNodeInfo(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.LocalNodeInfo(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2netmap.LocalNodeInfoResponse)
@@ -163,10 +165,12 @@ func (c *Client) NetworkInfo(ctx context.Context, prm PrmNetworkInfo) (*ResNetwo
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "NetworkInfo"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.NetworkInfo(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.NetworkInfo(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2netmap.NetworkInfoResponse)
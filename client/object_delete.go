@@ -12,6 +12,8 @@ import (
 	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 )
@@ -24,8 +26,7 @@ type PrmObjectDelete struct {
 
 	addr v2refs.Address
 
-	keySet bool
-	key    ecdsa.PrivateKey
+	signer neofscrypto.Signer
 }
 
 // WithinSession specifies session within which object should be read.
@@ -72,9 +73,16 @@ func (x *PrmObjectDelete) ByID(id oid.ID) {
 
 // UseKey specifies private key to sign the requests.
 // If key is not provided, then Client default key is used.
+//
+// See also UseSigner.
 func (x *PrmObjectDelete) UseKey(key ecdsa.PrivateKey) {
-	x.keySet = true
-	x.key = key
+	x.signer = neofsecdsa.Signer(key)
+}
+
+// UseSigner specifies signer of the requests.
+// If signer is not provided, then Client default signer is used.
+func (x *PrmObjectDelete) UseSigner(signer neofscrypto.Signer) {
+	x.signer = signer
 }
 
 // WithXHeaders specifies list of extended headers (string key-value pairs)
@@ -154,17 +162,20 @@ func (c *Client) ObjectDelete(ctx context.Context, prm PrmObjectDelete) (*ResObj
 		res ResObjectDelete
 	)
 
-	if prm.keySet {
-		c.initCallContextWithoutKey(&cc)
-		cc.key = prm.key
+	if prm.signer != nil {
+		c.initCallContextWithoutSigner(&cc)
+		cc.signer = prm.signer
 	} else {
 		c.initCallContext(&cc)
 	}
 
+	cc.ctx = ctx
+	cc.method = "ObjectDelete"
+	cc.traceAddress(prm.addr)
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.DeleteObject(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.DeleteObject(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		res.idTomb = r.(*v2object.DeleteResponse).GetBody().GetTombstone().GetObjectID()
@@ -16,6 +16,8 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
@@ -137,8 +139,16 @@ type ObjectReader struct {
 
 // UseKey specifies private key to sign the requests.
 // If key is not provided, then Client default key is used.
+//
+// See also UseSigner.
 func (x *ObjectReader) UseKey(key ecdsa.PrivateKey) {
-	x.ctxCall.key = key
+	x.ctxCall.signer = neofsecdsa.Signer(key)
+}
+
+// UseSigner specifies signer of the requests.
+// If signer is not provided, then Client default signer is used.
+func (x *ObjectReader) UseSigner(signer neofscrypto.Signer) {
+	x.ctxCall.signer = signer
 }
 
 func handleSplitInfo(ctx *contextCall, i *v2object.SplitInfo) {
@@ -241,8 +251,9 @@ func (x *ObjectReader) ReadChunk(buf []byte) (int, bool) {
 	return x.readChunk(buf)
 }
 
-func (x *ObjectReader) close(ignoreEOF bool) (*ResObjectGet, error) {
+func (x *ObjectReader) close(ignoreEOF bool) (res *ResObjectGet, err error) {
 	defer x.cancelCtxStream()
+	defer func() { x.ctxCall.endSpan(err) }()
 
 	if x.ctxCall.err != nil {
 		if !errors.Is(x.ctxCall.err, io.EOF) {
@@ -363,13 +374,17 @@ func (c *Client) ObjectGetInit(ctx context.Context, prm PrmObjectGet) (*ObjectRe
 
 	// init call context
 	c.initCallContext(&r.ctxCall)
+	r.ctxCall.ctx = ctx
+	r.ctxCall.method = "ObjectGetInit"
+	r.ctxCall.cnr = &prm.cnrID
+	r.ctxCall.obj = &prm.objID
 	r.ctxCall.req = &req
 	r.ctxCall.statusRes = new(ResObjectGet)
 	r.ctxCall.resp = &resp
 	r.ctxCall.wReq = func() error {
 		var err error
 
-		stream, err = rpcapi.GetObject(&c.c, &req, client.WithContext(ctx))
+		stream, err = rpcapi.GetObject(&c.c, &req, client.WithContext(r.ctxCall.ctx))
 		if err != nil {
 			return fmt.Errorf("open stream: %w", err)
 		}
@@ -380,6 +395,8 @@ func (c *Client) ObjectGetInit(ctx context.Context, prm PrmObjectGet) (*ObjectRe
 		return stream.Read(&resp)
 	}
 
+	r.ctxCall.startSpan()
+
 	return &r, nil
 }
 
@@ -387,15 +404,21 @@ func (c *Client) ObjectGetInit(ctx context.Context, prm PrmObjectGet) (*ObjectRe
 type PrmObjectHead struct {
 	prmObjectRead
 
-	keySet bool
-	key    ecdsa.PrivateKey
+	signer neofscrypto.Signer
 }
 
 // UseKey specifies private key to sign the requests.
 // If key is not provided, then Client default key is used.
+//
+// See also UseSigner.
 func (x *PrmObjectHead) UseKey(key ecdsa.PrivateKey) {
-	x.keySet = true
-	x.key = key
+	x.signer = neofsecdsa.Signer(key)
+}
+
+// UseSigner specifies signer of the requests.
+// If signer is not provided, then Client default signer is used.
+func (x *PrmObjectHead) UseSigner(signer neofscrypto.Signer) {
+	x.signer = signer
 }
 
 // ResObjectHead groups resulting values of ObjectHead operation.
@@ -497,17 +520,21 @@ func (c *Client) ObjectHead(ctx context.Context, prm PrmObjectHead) (*ResObjectH
 
 	res.idObj = prm.objID
 
-	if prm.keySet {
-		c.initCallContextWithoutKey(&cc)
-		cc.key = prm.key
+	if prm.signer != nil {
+		c.initCallContextWithoutSigner(&cc)
+		cc.signer = prm.signer
 	} else {
 		c.initCallContext(&cc)
 	}
 
+	cc.ctx = ctx
+	cc.method = "ObjectHead"
+	cc.cnr = &prm.cnrID
+	cc.obj = &prm.objID
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.HeadObject(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.HeadObject(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		switch v := r.(*v2object.HeadResponse).GetBody().GetHeaderPart().(type) {
@@ -574,8 +601,16 @@ type ObjectRangeReader struct {
 
 // UseKey specifies private key to sign the requests.
 // If key is not provided, then Client default key is used.
+//
+// See also UseSigner.
 func (x *ObjectRangeReader) UseKey(key ecdsa.PrivateKey) {
-	x.ctxCall.key = key
+	x.ctxCall.signer = neofsecdsa.Signer(key)
+}
+
+// UseSigner specifies signer of the requests.
+// If signer is not provided, then Client default signer is used.
+func (x *ObjectRangeReader) UseSigner(signer neofscrypto.Signer) {
+	x.ctxCall.signer = signer
 }
 
 func (x *ObjectRangeReader) readChunk(buf []byte) (int, bool) {
@@ -649,8 +684,9 @@ func (x *ObjectRangeReader) ReadChunk(buf []byte) (int, bool) {
 	return x.readChunk(buf)
 }
 
-func (x *ObjectRangeReader) close(ignoreEOF bool) (*ResObjectRange, error) {
+func (x *ObjectRangeReader) close(ignoreEOF bool) (res *ResObjectRange, err error) {
 	defer x.cancelCtxStream()
+	defer func() { x.ctxCall.endSpan(err) }()
 
 	if x.ctxCall.err != nil {
 		if !errors.Is(x.ctxCall.err, io.EOF) {
@@ -782,13 +818,17 @@ func (c *Client) ObjectRangeInit(ctx context.Context, prm PrmObjectRange) (*Obje
 
 	// init call context
 	c.initCallContext(&r.ctxCall)
+	r.ctxCall.ctx = ctx
+	r.ctxCall.method = "ObjectRangeInit"
+	r.ctxCall.cnr = &prm.cnrID
+	r.ctxCall.obj = &prm.objID
 	r.ctxCall.req = &req
 	r.ctxCall.statusRes = new(ResObjectRange)
 	r.ctxCall.resp = &resp
 	r.ctxCall.wReq = func() error {
 		var err error
 
-		stream, err = rpcapi.GetObjectRange(&c.c, &req, client.WithContext(ctx))
+		stream, err = rpcapi.GetObjectRange(&c.c, &req, client.WithContext(r.ctxCall.ctx))
 		if err != nil {
 			return fmt.Errorf("open stream: %w", err)
 		}
@@ -799,5 +839,7 @@ func (c *Client) ObjectRangeInit(ctx context.Context, prm PrmObjectRange) (*Obje
 		return stream.Read(&resp)
 	}
 
+	r.ctxCall.startSpan()
+
 	return &r, nil
 }
@@ -187,10 +187,13 @@ func (c *Client) ObjectHash(ctx context.Context, prm PrmObjectHash) (*ResObjectH
 	)
 
 	c.initCallContext(&cc)
+	cc.ctx = ctx
+	cc.method = "ObjectHash"
+	cc.traceAddress(prm.addr)
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.HashObjectRange(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.HashObjectRange(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		res.checksums = r.(*v2object.GetRangeHashResponse).GetBody().GetHashList()
@@ -13,6 +13,8 @@ import (
 	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
 	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
@@ -66,8 +68,16 @@ type ObjectWriter struct {
 
 // UseKey specifies private key to sign the requests.
 // If key is not provided, then Client default key is used.
+//
+// See also UseSigner.
 func (x *ObjectWriter) UseKey(key ecdsa.PrivateKey) {
-	x.ctxCall.key = key
+	x.ctxCall.signer = neofsecdsa.Signer(key)
+}
+
+// UseSigner specifies signer of the requests.
+// If signer is not provided, then Client default signer is used.
+func (x *ObjectWriter) UseSigner(signer neofscrypto.Signer) {
+	x.ctxCall.signer = signer
 }
 
 // WithBearerToken attaches bearer token to be used for the operation.
@@ -113,6 +123,10 @@ func (x *ObjectWriter) WriteHeader(hdr object.Object) bool {
 	x.partInit.SetHeader(v2Hdr.GetHeader())
 	x.partInit.SetSignature(v2Hdr.GetSignature())
 
+	if cnr, ok := hdr.ContainerID(); ok {
+		x.ctxCall.cnr = &cnr
+	}
+
 	return x.ctxCall.writeRequest()
 }
 
@@ -173,8 +187,9 @@ func (x *ObjectWriter) WritePayloadChunk(chunk []byte) bool {
 //   - *apistatus.LockNonRegularObject;
 //   - *apistatus.SessionTokenNotFound;
 //   - *apistatus.SessionTokenExpired.
-func (x *ObjectWriter) Close() (*ResObjectPut, error) {
+func (x *ObjectWriter) Close() (res *ResObjectPut, err error) {
 	defer x.cancelCtxStream()
+	defer func() { x.ctxCall.endSpan(err) }()
 
 	// Ignore io.EOF error, because it is expected error for client-side
 	// stream termination by the server. E.g. when stream contains invalid
@@ -183,15 +198,23 @@ func (x *ObjectWriter) Close() (*ResObjectPut, error) {
 		return nil, x.ctxCall.err
 	}
 
-	if !x.ctxCall.close() {
+	var info CallInfo
+
+	ok := x.ctxCall.intercept(&info, func() bool {
+		return x.ctxCall.close() && x.ctxCall.receiveResponse(&info)
+	})
+	if !ok {
 		return nil, x.ctxCall.err
 	}
 
-	if !x.ctxCall.processResponse() {
-		return nil, x.ctxCall.err
+	res = x.ctxCall.statusRes.(*ResObjectPut)
+
+	var id oid.ID
+	if res.ReadStoredObjectID(&id) {
+		x.ctxCall.obj = &id
 	}
 
-	return x.ctxCall.statusRes.(*ResObjectPut), nil
+	return res, nil
 }
 
 // ObjectPutInit initiates writing an object through a remote server using NeoFS API protocol.
@@ -206,7 +229,6 @@ func (c *Client) ObjectPutInit(ctx context.Context, _ PrmObjectPutInit) (*Object
 		panic(panicMsgMissingContext)
 	}
 
-	// open stream
 	var (
 		res ResObjectPut
 		w   ObjectWriter
@@ -214,11 +236,6 @@ func (c *Client) ObjectPutInit(ctx context.Context, _ PrmObjectPutInit) (*Object
 
 	ctx, w.cancelCtxStream = context.WithCancel(ctx)
 
-	stream, err := rpcapi.PutObject(&c.c, &res.resp, client.WithContext(ctx))
-	if err != nil {
-		return nil, fmt.Errorf("open stream: %w", err)
-	}
-
 	// form request body
 	var body v2object.PutRequestBody
 
@@ -232,9 +249,23 @@ func (c *Client) ObjectPutInit(ctx context.Context, _ PrmObjectPutInit) (*Object
 
 	// init call context
 	c.initCallContext(&w.ctxCall)
+	w.ctxCall.ctx = ctx
+	w.ctxCall.method = "ObjectPutInit"
 	w.ctxCall.req = &req
 	w.ctxCall.statusRes = &res
 	w.ctxCall.resp = &res.resp
+	w.ctxCall.startSpan()
+
+	// open stream within the span context
+	stream, err := rpcapi.PutObject(&c.c, &res.resp, client.WithContext(w.ctxCall.ctx))
+	if err != nil {
+		err = fmt.Errorf("open stream: %w", err)
+		w.ctxCall.endSpan(err)
+		w.cancelCtxStream()
+
+		return nil, err
+	}
+
 	w.ctxCall.wReq = func() error {
 		return stream.Write(&req)
 	}
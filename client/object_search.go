@@ -16,6 +16,8 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
@@ -101,8 +103,16 @@ type ObjectListReader struct {
 
 // UseKey specifies private key to sign the requests.
 // If key is not provided, then Client default key is used.
+//
+// See also UseSigner.
 func (x *ObjectListReader) UseKey(key ecdsa.PrivateKey) {
-	x.ctxCall.key = key
+	x.ctxCall.signer = neofsecdsa.Signer(key)
+}
+
+// UseSigner specifies signer of the requests.
+// If signer is not provided, then Client default signer is used.
+func (x *ObjectListReader) UseSigner(signer neofscrypto.Signer) {
+	x.ctxCall.signer = signer
 }
 
 // Read reads another list of the object identifiers. Works similar to
@@ -216,8 +226,9 @@ func (x *ObjectListReader) Iterate(f func(oid.ID) bool) error {
 //   - *apistatus.ContainerNotFound;
 //   - *apistatus.ObjectAccessDenied;
 //   - *apistatus.SessionTokenExpired.
-func (x *ObjectListReader) Close() (*ResObjectSearch, error) {
+func (x *ObjectListReader) Close() (res *ResObjectSearch, err error) {
 	defer x.cancelCtxStream()
+	defer func() { x.ctxCall.endSpan(err) }()
 
 	if x.ctxCall.err != nil && !errors.Is(x.ctxCall.err, io.EOF) {
 		return nil, x.ctxCall.err
@@ -296,13 +307,16 @@ func (c *Client) ObjectSearchInit(ctx context.Context, prm PrmObjectSearch) (*Ob
 
 	// init call context
 	c.initCallContext(&r.ctxCall)
+	r.ctxCall.ctx = ctx
+	r.ctxCall.method = "ObjectSearchInit"
+	r.ctxCall.cnr = &prm.cnrID
 	r.ctxCall.req = &req
 	r.ctxCall.statusRes = new(ResObjectSearch)
 	r.ctxCall.resp = &resp
 	r.ctxCall.wReq = func() error {
 		var err error
 
-		stream, err = rpcapi.SearchObjects(&c.c, &req, client.WithContext(ctx))
+		stream, err = rpcapi.SearchObjects(&c.c, &req, client.WithContext(r.ctxCall.ctx))
 		if err != nil {
 			return fmt.Errorf("open stream: %w", err)
 		}
@@ -313,5 +327,7 @@ func (c *Client) ObjectSearchInit(ctx context.Context, prm PrmObjectSearch) (*Ob
 		return stream.Read(&resp)
 	}
 
+	r.ctxCall.startSpan()
+
 	return &r, nil
 }
@@ -84,10 +84,12 @@ func (c *Client) AnnounceLocalTrust(ctx context.Context, prm PrmAnnounceLocalTru
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "AnnounceLocalTrust"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.AnnounceLocalTrust(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.AnnounceLocalTrust(&c.c, &req, client.WithContext(cc.ctx))
 	}
 
 	// process call
@@ -179,10 +181,12 @@ func (c *Client) AnnounceIntermediateTrust(ctx context.Context, prm PrmAnnounceI
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "AnnounceIntermediateTrust"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.AnnounceIntermediateResult(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.AnnounceIntermediateResult(&c.c, &req, client.WithContext(cc.ctx))
 	}
 
 	// process call
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// ErrObjectChanged is returned by ResumablePayload if the object header read
+// on resume doesn't match the one read initially.
+var ErrObjectChanged = errors.New("object header changed")
+
+// PayloadOpener opens the stream of the object payload starting from the given
+// offset. Along with the stream, the current object header must be returned:
+// it is used to check that the object is not changed since the reading start.
+// If the offset is not less than the payload size, the stream must be empty.
+//
+// See also Client.ObjectPayloadOpener.
+type PayloadOpener func(ctx context.Context, off uint64) (object.Object, io.ReadCloser, error)
+
+// ResumablePayload is a reader of the object payload which continues reading
+// after the stream failure from the current offset. Failures are hidden from
+// the caller while resumes are left.
+//
+// Must be initialized using NewResumablePayload, any other usage is unsafe.
+type ResumablePayload struct {
+	ctx context.Context
+
+	hdr object.Object
+
+	open PayloadOpener
+
+	resumable func(error) bool
+
+	// current stream, nil if it should be reopened
+	r io.ReadCloser
+
+	// number of delivered bytes
+	off     uint64
+	resumes int
+}
+
+// NewResumablePayload wraps the payload stream of the object with the given
+// header. The stream is reopened by the given PayloadOpener at most resumes
+// times. Context is passed to the PayloadOpener, reading is stopped if the
+// context is done.
+//
+// By default, the stream is resumed after any error except NeoFS API statuses
+// (other than server internal error) and context errors.
+// See also SetResumeFilter.
+//
+// Usage example for the Client:
+//
+//	var prmGet client.PrmObjectGet
+//	prmGet.FromContainer(cnr)
+//	prmGet.ByID(id)
+//
+//	r, err := c.ObjectGetInit(ctx, prmGet)
+//	// ...
+//
+//	var hdr object.Object
+//	if !r.ReadHeader(&hdr) {
+//		// ...
+//	}
+//
+//	var prmRange client.PrmObjectRange
+//	prmRange.FromContainer(cnr)
+//	prmRange.ByID(id)
+//
+//	payload := client.NewResumablePayload(ctx, hdr, io.NopCloser(r), 3, c.ObjectPayloadOpener(prmRange))
+//	// read payload
+func NewResumablePayload(ctx context.Context, hdr object.Object, r io.ReadCloser, resumes int, open PayloadOpener) *ResumablePayload {
+	return &ResumablePayload{
+		ctx:       ctx,
+		hdr:       hdr,
+		open:      open,
+		resumable: isResumableError,
+		r:         r,
+		resumes:   resumes,
+	}
+}
+
+// SetResumeFilter sets function which decides if the stream should be resumed
+// after the given error. Context errors and ErrObjectChanged are never resumed.
+func (x *ResumablePayload) SetResumeFilter(f func(error) bool) {
+	x.resumable = f
+}
+
+// Read implements io.Reader of the object payload.
+func (x *ResumablePayload) Read(p []byte) (int, error) {
+	for {
+		if x.r == nil {
+			if err := x.resume(); err != nil {
+				if x.canResume(err) {
+					continue
+				}
+
+				return 0, err
+			}
+		}
+
+		n, err := x.r.Read(p)
+		x.off += uint64(n)
+
+		if err == nil || errors.Is(err, io.EOF) || !x.canResume(err) {
+			return n, err
+		}
+
+		_ = x.r.Close()
+		x.r = nil
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// Close implements io.Closer of the object payload.
+func (x *ResumablePayload) Close() error {
+	if x.r == nil {
+		return nil
+	}
+
+	err := x.r.Close()
+	x.r = nil
+
+	return err
+}
+
+func (x *ResumablePayload) canResume(err error) bool {
+	return x.resumes > 0 && x.ctx.Err() == nil && !errors.Is(err, ErrObjectChanged) && x.resumable(err)
+}
+
+// resume checks that the object is not changed and opens the stream of the
+// rest of the payload.
+func (x *ResumablePayload) resume() error {
+	x.resumes--
+
+	hdr, r, err := x.open(x.ctx, x.off)
+	if err != nil {
+		return fmt.Errorf("open payload from offset %d: %w", x.off, err)
+	}
+
+	if err = checkSameObject(x.hdr, hdr); err != nil {
+		_ = r.Close()
+		return err
+	}
+
+	x.r = r
+
+	return nil
+}
+
+// isResumableError is the default resume filter of ResumablePayload.
+func isResumableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var st apistatus.StatusV2
+	if !errors.As(err, &st) {
+		// transport or other client-side failure
+		return true
+	}
+
+	var errInternal apistatus.ServerInternal
+	var errInternalPtr *apistatus.ServerInternal
+
+	return errors.As(err, &errInternal) || errors.As(err, &errInternalPtr)
+}
+
+// checkSameObject checks that the headers describe the same object payload.
+func checkSameObject(expected, actual object.Object) error {
+	idExp, okExp := expected.ID()
+	id, ok := actual.ID()
+	if okExp != ok || ok && !id.Equals(idExp) {
+		return fmt.Errorf("%w: ID %s instead of %s", ErrObjectChanged, id, idExp)
+	}
+
+	if expected.PayloadSize() != actual.PayloadSize() {
+		return fmt.Errorf("%w: payload size %d instead of %d", ErrObjectChanged, actual.PayloadSize(), expected.PayloadSize())
+	}
+
+	csExp, okExp := expected.PayloadChecksum()
+	cs, ok := actual.PayloadChecksum()
+	if okExp != ok || ok && (cs.Type() != csExp.Type() || !bytes.Equal(cs.Value(), csExp.Value())) {
+		return fmt.Errorf("%w: payload checksum %s instead of %s", ErrObjectChanged, cs, csExp)
+	}
+
+	return nil
+}
+
+// ObjectPayloadOpener returns PayloadOpener which reads the object header and
+// the rest of the payload through the Client. The object and the request
+// parameters are taken from prm, offset and length of the range are ignored.
+// Requests are signed with the Client default signer.
+func (c *Client) ObjectPayloadOpener(prm PrmObjectRange) PayloadOpener {
+	return func(ctx context.Context, off uint64) (object.Object, io.ReadCloser, error) {
+		var hdr object.Object
+
+		var prmHead PrmObjectHead
+		prmHead.prmObjectRead = prm.prmObjectRead
+
+		resHead, err := c.ObjectHead(ctx, prmHead)
+		if err == nil {
+			err = apistatus.ErrFromStatus(resHead.Status())
+		}
+		if err != nil {
+			return hdr, nil, fmt.Errorf("read object header: %w", err)
+		}
+
+		if !resHead.ReadHeader(&hdr) {
+			return hdr, nil, errors.New("missing object header in the response")
+		}
+
+		size := hdr.PayloadSize()
+		if off >= size {
+			return hdr, io.NopCloser(bytes.NewReader(nil)), nil
+		}
+
+		prm.SetOffset(off)
+		prm.SetLength(size - off)
+
+		r, err := c.ObjectRangeInit(ctx, prm)
+		if err != nil {
+			return hdr, nil, fmt.Errorf("open payload range: %w", err)
+		}
+
+		return hdr, rangeReadCloser{r}, nil
+	}
+}
+
+// rangeReadCloser adapts ObjectRangeReader to io.ReadCloser.
+type rangeReadCloser struct {
+	r *ObjectRangeReader
+}
+
+func (x rangeReadCloser) Read(p []byte) (int, error) {
+	return x.r.Read(p)
+}
+
+func (x rangeReadCloser) Close() error {
+	_, err := x.r.Close()
+	return err
+}
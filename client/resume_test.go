@@ -0,0 +1,136 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+// brokenReader returns the data and then fails.
+type brokenReader struct {
+	r   io.Reader
+	err error
+}
+
+func (x *brokenReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		err = x.err
+	}
+
+	return n, err
+}
+
+func (x *brokenReader) Close() error {
+	return nil
+}
+
+func TestCheckSameObject(t *testing.T) {
+	hdr := object.New()
+	hdr.SetID(oidtest.ID())
+	hdr.SetPayloadSize(10)
+
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.SHA256, []byte("payload"))
+	hdr.SetPayloadChecksum(cs)
+
+	require.NoError(t, checkSameObject(*hdr, *hdr))
+
+	changed := object.New()
+	changed.SetID(oidtest.ID())
+	changed.SetPayloadSize(10)
+	changed.SetPayloadChecksum(cs)
+	require.ErrorIs(t, checkSameObject(*hdr, *changed), ErrObjectChanged)
+
+	id, _ := hdr.ID()
+	changed.SetID(id)
+	changed.SetPayloadSize(11)
+	require.ErrorIs(t, checkSameObject(*hdr, *changed), ErrObjectChanged)
+
+	changed.SetPayloadSize(10)
+	checksum.Calculate(&cs, checksum.SHA256, []byte("other payload"))
+	changed.SetPayloadChecksum(cs)
+	require.ErrorIs(t, checkSameObject(*hdr, *changed), ErrObjectChanged)
+}
+
+func TestResumablePayload(t *testing.T) {
+	payload := make([]byte, 1000)
+	rand.Read(payload)
+
+	hdr := object.New()
+	hdr.SetID(oidtest.ID())
+	hdr.SetPayloadSize(uint64(len(payload)))
+
+	headRes := hdr
+	errBroken := errors.New("connection reset")
+
+	var opened []uint64
+
+	open := func(_ context.Context, off uint64) (object.Object, io.ReadCloser, error) {
+		opened = append(opened, off)
+
+		var r io.Reader = bytes.NewReader(payload[off:])
+		if off < 600 {
+			// the first resumed stream is broken too
+			r = &brokenReader{r: bytes.NewReader(payload[off:600]), err: errBroken}
+		}
+
+		return *headRes, io.NopCloser(r), nil
+	}
+
+	read := func(resumes int, filter func(error) bool) ([]byte, error) {
+		opened = nil
+
+		r := NewResumablePayload(context.Background(), *hdr,
+			&brokenReader{r: bytes.NewReader(payload[:300]), err: errBroken}, resumes, open)
+		if filter != nil {
+			r.SetResumeFilter(filter)
+		}
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, r.Close())
+
+		return data, err
+	}
+
+	_, err := read(0, nil)
+	require.ErrorIs(t, err, errBroken)
+	require.Empty(t, opened)
+
+	_, err = read(1, nil)
+	require.ErrorIs(t, err, errBroken)
+	require.Equal(t, []uint64{300}, opened)
+
+	data, err := read(2, nil)
+	require.NoError(t, err)
+	require.Equal(t, payload, data)
+	require.Equal(t, []uint64{300, 600}, opened)
+
+	_, err = read(2, func(error) bool { return false })
+	require.ErrorIs(t, err, errBroken)
+	require.Empty(t, opened)
+
+	headRes = object.New()
+	headRes.SetID(oidtest.ID())
+	headRes.SetPayloadSize(uint64(len(payload)))
+
+	_, err = read(2, nil)
+	require.ErrorIs(t, err, ErrObjectChanged)
+	require.Equal(t, []uint64{300}, opened)
+}
+
+func TestIsResumableError(t *testing.T) {
+	require.True(t, isResumableError(errors.New("connection reset")))
+	require.True(t, isResumableError(apistatus.ServerInternal{}))
+	require.False(t, isResumableError(apistatus.ObjectNotFound{}))
+	require.False(t, isResumableError(context.Canceled))
+}
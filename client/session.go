@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	rpcapi "github.com/nspcc-dev/neofs-api-go/v2/rpc"
@@ -72,8 +74,16 @@ func (c *Client) SessionCreate(ctx context.Context, prm PrmSessionCreate) (*ResS
 		panic(panicMsgMissingContext)
 	}
 
+	if c.prm.signer == nil {
+		return nil, errors.New("missing signer")
+	}
+
 	var ownerID user.ID
-	user.IDFromKey(&ownerID, c.prm.key.PublicKey)
+
+	err := user.IDFromSigner(&ownerID, c.prm.signer)
+	if err != nil {
+		return nil, fmt.Errorf("session owner from the signer: %w", err)
+	}
 
 	var ownerIDV2 refs.OwnerID
 	ownerID.WriteToV2(&ownerIDV2)
@@ -97,10 +107,12 @@ func (c *Client) SessionCreate(ctx context.Context, prm PrmSessionCreate) (*ResS
 
 	c.initCallContext(&cc)
 	cc.meta = prm.prmCommonMeta
+	cc.ctx = ctx
+	cc.method = "SessionCreate"
 	cc.req = &req
 	cc.statusRes = &res
 	cc.call = func() (responseV2, error) {
-		return rpcapi.CreateSession(&c.c, &req, client.WithContext(ctx))
+		return rpcapi.CreateSession(&c.c, &req, client.WithContext(cc.ctx))
 	}
 	cc.result = func(r responseV2) {
 		resp := r.(*v2session.CreateResponse)
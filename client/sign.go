@@ -0,0 +1,134 @@
+package client
+
+import (
+	"fmt"
+
+	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	v2container "github.com/nspcc-dev/neofs-api-go/v2/container"
+	v2netmap "github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	v2reputation "github.com/nspcc-dev/neofs-api-go/v2/reputation"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// message part signed in the verification header.
+type stableMarshaler interface {
+	StableMarshal([]byte) ([]byte, error)
+}
+
+// request signed by the Client.
+type request interface {
+	GetMetaHeader() *v2session.RequestMetaHeader
+	SetVerificationHeader(*v2session.RequestVerificationHeader)
+}
+
+// returns body of the request sent by the Client.
+func requestBody(req request) stableMarshaler {
+	switch v := req.(type) {
+	default:
+		panic(fmt.Sprintf("unsupported request %T", req))
+	case *v2accounting.BalanceRequest:
+		return v.GetBody()
+	case *v2container.PutRequest:
+		return v.GetBody()
+	case *v2container.GetRequest:
+		return v.GetBody()
+	case *v2container.ListRequest:
+		return v.GetBody()
+	case *v2container.DeleteRequest:
+		return v.GetBody()
+	case *v2container.GetExtendedACLRequest:
+		return v.GetBody()
+	case *v2container.SetExtendedACLRequest:
+		return v.GetBody()
+	case *v2container.AnnounceUsedSpaceRequest:
+		return v.GetBody()
+	case *v2netmap.LocalNodeInfoRequest:
+		return v.GetBody()
+	case *v2netmap.NetworkInfoRequest:
+		return v.GetBody()
+	case *v2object.PutRequest:
+		return v.GetBody()
+	case *v2object.GetRequest:
+		return v.GetBody()
+	case *v2object.HeadRequest:
+		return v.GetBody()
+	case *v2object.GetRangeRequest:
+		return v.GetBody()
+	case *v2object.GetRangeHashRequest:
+		return v.GetBody()
+	case *v2object.DeleteRequest:
+		return v.GetBody()
+	case *v2object.SearchRequest:
+		return v.GetBody()
+	case *v2reputation.AnnounceLocalTrustRequest:
+		return v.GetBody()
+	case *v2reputation.AnnounceIntermediateResultRequest:
+		return v.GetBody()
+	case *v2session.CreateRequest:
+		return v.GetBody()
+	}
+}
+
+// signs the request part using the signer. Nil part is signed as empty data.
+func signRequestPart(signer neofscrypto.Signer, part stableMarshaler) (*refs.Signature, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if part != nil {
+		data, err = part.StableMarshal(nil)
+		if err != nil {
+			return nil, fmt.Errorf("marshal: %w", err)
+		}
+	}
+
+	var sig neofscrypto.Signature
+
+	err = sig.Calculate(signer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var sigV2 refs.Signature
+	sig.WriteToV2(&sigV2)
+
+	return &sigV2, nil
+}
+
+// signs the request originated by the Client and sets its verification header.
+// The procedure is compatible with the one of SignServiceMessage function from
+// github.com/nspcc-dev/neofs-api-go/v2/signature package, but allows to use any
+// signature scheme.
+func signRequest(signer neofscrypto.Signer, req request) error {
+	var hdr v2session.RequestVerificationHeader
+
+	sig, err := signRequestPart(signer, requestBody(req))
+	if err != nil {
+		return fmt.Errorf("sign body: %w", err)
+	}
+
+	hdr.SetBodySignature(sig)
+
+	sig, err = signRequestPart(signer, req.GetMetaHeader())
+	if err != nil {
+		return fmt.Errorf("sign meta header: %w", err)
+	}
+
+	hdr.SetMetaSignature(sig)
+
+	// there is no origin verification header, empty data is signed
+	sig, err = signRequestPart(signer, nil)
+	if err != nil {
+		return fmt.Errorf("sign origin verification header: %w", err)
+	}
+
+	hdr.SetOriginSignature(sig)
+
+	req.SetVerificationHeader(&hdr)
+
+	return nil
+}
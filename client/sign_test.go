@@ -0,0 +1,105 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	v2refs "github.com/nspcc-dev/neofs-api-go/v2/refs"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	signatureV2 "github.com/nspcc-dev/neofs-api-go/v2/signature"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignRequest(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var body v2accounting.BalanceRequestBody
+
+	var meta v2session.RequestMetaHeader
+	meta.SetTTL(2)
+
+	var req v2accounting.BalanceRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(&meta)
+
+	require.NoError(t, signRequest(neofsecdsa.Signer(k.PrivateKey), &req))
+	require.NoError(t, signatureV2.VerifyServiceMessage(&req))
+
+	require.NoError(t, signRequest(neofsecdsa.SignerRFC6979(k.PrivateKey), &req))
+
+	hdr := req.GetVerificationHeader()
+	require.Nil(t, hdr.GetOrigin())
+
+	for _, part := range []struct {
+		data stableMarshaler
+		sig  *v2refs.Signature
+	}{
+		{&body, hdr.GetBodySignature()},
+		{&meta, hdr.GetMetaSignature()},
+	} {
+		data, err := part.data.StableMarshal(nil)
+		require.NoError(t, err)
+
+		require.EqualValues(t, neofscrypto.ECDSA_DETERMINISTIC_SHA256, part.sig.GetScheme())
+
+		var sig neofscrypto.Signature
+		sig.ReadFromV2(*part.sig)
+
+		require.True(t, sig.Verify(data))
+	}
+
+	errSign := errors.New("any error")
+
+	err = signRequest(neofscryptotest.FailSigner(errSign), &req)
+	require.ErrorIs(t, err, errSign)
+}
+
+func TestContextCall_Signer(t *testing.T) {
+	errSign := errors.New("any error")
+
+	var prm PrmInit
+
+	cc, _ := testBalanceCall(t, prm)
+	cc.signer = neofscryptotest.FailSigner(errSign)
+
+	require.False(t, cc.processCall())
+	require.ErrorIs(t, cc.err, errSign)
+
+	cc, _ = testBalanceCall(t, prm)
+	cc.signer = nil
+
+	require.False(t, cc.processCall())
+	require.Error(t, cc.err)
+}
+
+func TestClient_containerSigner(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var c Client
+
+	_, err = c.containerSigner()
+	require.Error(t, err)
+
+	for _, signer := range []neofscrypto.Signer{
+		neofsecdsa.Signer(k.PrivateKey),
+		neofsecdsa.SignerRFC6979(k.PrivateKey),
+	} {
+		c.prm.SetDefaultSigner(signer)
+
+		res, err := c.containerSigner()
+		require.NoError(t, err)
+		require.Equal(t, neofscrypto.ECDSA_DETERMINISTIC_SHA256, res.Scheme())
+	}
+
+	c.prm.SetDefaultSigner(neofscryptotest.FailSigner(errors.New("any error")))
+
+	_, err = c.containerSigner()
+	require.Error(t, err)
+}
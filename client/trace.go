@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+
+	v2refs "github.com/nspcc-dev/neofs-api-go/v2/refs"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Tracer opens spans of the Client operations. Interface is designed to be
+// easily implemented on top of the tracing libraries like OpenTelemetry.
+//
+// See also PrmInit.SetTracer.
+type Tracer interface {
+	// Start opens the span of the named operation as a child of the span
+	// carried by the context (if any). Returned context must carry the
+	// opened span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span represents the traced Client operation.
+type Span interface {
+	// TraceID returns hex-encoded identifier of the trace the span belongs to.
+	// Empty ID isn't sent to the server.
+	TraceID() string
+
+	// SetAttribute sets string attribute of the span.
+	SetAttribute(key, value string)
+
+	// End finishes the span. Non-nil error means operation failure.
+	End(err error)
+}
+
+// Attributes of the operation spans.
+const (
+	// AttributeContainerID is a string-encoded ID of the container referenced
+	// by the operation.
+	AttributeContainerID = "neofs.container_id"
+
+	// AttributeObjectID is a string-encoded ID of the object referenced
+	// by the operation.
+	AttributeObjectID = "neofs.object_id"
+
+	// AttributeNodeAddress is an address of the server.
+	AttributeNodeAddress = "neofs.node_address"
+
+	// AttributeStatusCode is a decimal code of the status returned by the
+	// server.
+	AttributeStatusCode = "neofs.status_code"
+)
+
+// XHeaderTraceID is a key of the request X-header carrying the trace ID of
+// the operation span, so the server can log it.
+const XHeaderTraceID = "X-Trace-ID"
+
+// opens span of the call if tracer is set. The span is finished by endSpan.
+func (x *contextCall) startSpan() {
+	if x.tracer != nil {
+		x.ctx, x.span = x.tracer.Start(x.ctx, x.method)
+	}
+}
+
+// finishes span of the call (if any) with the operation error. io.EOF is
+// treated as a successful end of the message stream.
+func (x *contextCall) endSpan(err error) {
+	if x.span == nil {
+		return
+	}
+
+	if x.cnr != nil {
+		x.span.SetAttribute(AttributeContainerID, x.cnr.EncodeToString())
+	}
+
+	if x.obj != nil {
+		x.span.SetAttribute(AttributeObjectID, x.obj.EncodeToString())
+	}
+
+	if x.endpoint != "" {
+		x.span.SetAttribute(AttributeNodeAddress, x.endpoint)
+	}
+
+	if x.received {
+		code := x.resp.GetMetaHeader().GetStatus().Code()
+		x.span.SetAttribute(AttributeStatusCode, strconv.FormatUint(uint64(code), 10))
+	}
+
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+
+	x.span.End(err)
+	x.span = nil
+}
+
+// sets container and object referenced by the call from the given address
+// if the call is traced.
+func (x *contextCall) traceAddress(addr v2refs.Address) {
+	if x.tracer == nil {
+		return
+	}
+
+	var a oid.Address
+
+	if a.ReadFromV2(addr) == nil {
+		cnr, obj := a.Container(), a.Object()
+		x.cnr, x.obj = &cnr, &obj
+	}
+}
+
+// writes trace ID of the call span (if any) to the request X-headers.
+func (x contextCall) writeTraceID(meta *v2session.RequestMetaHeader) {
+	if x.span == nil {
+		return
+	}
+
+	traceID := x.span.TraceID()
+	if traceID == "" {
+		return
+	}
+
+	hs := meta.GetXHeaders()
+
+	for i := range hs {
+		// messages of the stream share the header
+		if hs[i].GetKey() == XHeaderTraceID {
+			hs[i].SetValue(traceID)
+			return
+		}
+	}
+
+	var h v2session.XHeader
+	h.SetKey(XHeaderTraceID)
+	h.SetValue(traceID)
+
+	meta.SetXHeaders(append(hs, h))
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v2refs "github.com/nspcc-dev/neofs-api-go/v2/refs"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testSpanKey struct{}
+
+type testSpan struct {
+	name    string
+	traceID string
+	attrs   map[string]string
+	ended   int
+	err     error
+}
+
+func (x *testSpan) TraceID() string {
+	return x.traceID
+}
+
+func (x *testSpan) SetAttribute(key, value string) {
+	x.attrs[key] = value
+}
+
+func (x *testSpan) End(err error) {
+	x.ended++
+	x.err = err
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (x *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &testSpan{
+		name:    name,
+		traceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		attrs:   make(map[string]string),
+	}
+
+	x.spans = append(x.spans, s)
+
+	return context.WithValue(ctx, testSpanKey{}, s), s
+}
+
+func TestContextCall_Tracing(t *testing.T) {
+	var tracer testTracer
+
+	var prm PrmInit
+	prm.SetTracer(&tracer)
+	prm.AddInterceptor(func(ctx context.Context, _ *CallInfo, next func() error) error {
+		require.Equal(t, tracer.spans[len(tracer.spans)-1], ctx.Value(testSpanKey{}))
+		return next()
+	})
+
+	addr := oidtest.Address()
+
+	var addrV2 v2refs.Address
+	addr.WriteToV2(&addrV2)
+
+	cc, req := testBalanceCall(t, prm)
+	cc.traceAddress(addrV2)
+
+	// RPC is executed within the span context
+	call := cc.call
+	cc.call = func() (responseV2, error) {
+		require.Equal(t, tracer.spans[len(tracer.spans)-1], cc.ctx.Value(testSpanKey{}))
+		return call()
+	}
+
+	require.True(t, cc.processCall())
+	require.Len(t, tracer.spans, 1)
+
+	span := tracer.spans[0]
+	require.Equal(t, "BalanceGet", span.name)
+	require.Equal(t, 1, span.ended)
+	require.NoError(t, span.err)
+	require.Equal(t, map[string]string{
+		AttributeContainerID: addr.Container().EncodeToString(),
+		AttributeObjectID:    addr.Object().EncodeToString(),
+		AttributeNodeAddress: "localhost:8080",
+		AttributeStatusCode:  "1024",
+	}, span.attrs)
+
+	var xHdr v2session.XHeader
+	xHdr.SetKey(XHeaderTraceID)
+	xHdr.SetValue(span.traceID)
+
+	require.Equal(t, []v2session.XHeader{xHdr}, req.GetMetaHeader().GetXHeaders())
+
+	// header is not duplicated in the shared meta header
+	cc.span = span
+	cc.prepareRequest()
+	require.Equal(t, []v2session.XHeader{xHdr}, req.GetMetaHeader().GetXHeaders())
+
+	errCall := errors.New("any error")
+
+	cc, _ = testBalanceCall(t, prm)
+	cc.call = func() (responseV2, error) {
+		return nil, errCall
+	}
+
+	require.False(t, cc.processCall())
+	require.Len(t, tracer.spans, 2)
+
+	span = tracer.spans[1]
+	require.ErrorIs(t, span.err, errCall)
+	require.NotContains(t, span.attrs, AttributeStatusCode)
+}
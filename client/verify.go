@@ -0,0 +1,161 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	v2container "github.com/nspcc-dev/neofs-api-go/v2/container"
+	v2netmap "github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	v2reputation "github.com/nspcc-dev/neofs-api-go/v2/reputation"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// ResponseVerification defines how the Client verifies the server responses.
+//
+// See also PrmInit.SetResponseVerification.
+type ResponseVerification uint8
+
+const (
+	// ResponseVerificationStrict makes the Client to verify all signatures
+	// of the response verification header chain. Default mode.
+	ResponseVerificationStrict ResponseVerification = iota
+
+	// ResponseVerificationSkip makes the Client to skip the response
+	// verification. It saves resources, but should be used for the trusted
+	// links only (e.g. local ones).
+	ResponseVerificationSkip
+
+	// ResponseVerificationServerKey makes the Client to verify the responses
+	// like ResponseVerificationStrict and, additionally, to check that the
+	// responses are signed by the server with the expected public key (see
+	// PrmDial.SetServerKey). It allows to detect man-in-the-middle attacks and
+	// misrouted connections.
+	ResponseVerificationServerKey
+)
+
+// returns body of the response received by the Client.
+func responseBody(resp responseV2) stableMarshaler {
+	switch v := resp.(type) {
+	default:
+		panic(fmt.Sprintf("unsupported response %T", resp))
+	case *v2accounting.BalanceResponse:
+		return v.GetBody()
+	case *v2container.PutResponse:
+		return v.GetBody()
+	case *v2container.GetResponse:
+		return v.GetBody()
+	case *v2container.ListResponse:
+		return v.GetBody()
+	case *v2container.DeleteResponse:
+		return v.GetBody()
+	case *v2container.GetExtendedACLResponse:
+		return v.GetBody()
+	case *v2container.SetExtendedACLResponse:
+		return v.GetBody()
+	case *v2container.AnnounceUsedSpaceResponse:
+		return v.GetBody()
+	case *v2netmap.LocalNodeInfoResponse:
+		return v.GetBody()
+	case *v2netmap.NetworkInfoResponse:
+		return v.GetBody()
+	case *v2object.PutResponse:
+		return v.GetBody()
+	case *v2object.GetResponse:
+		return v.GetBody()
+	case *v2object.HeadResponse:
+		return v.GetBody()
+	case *v2object.GetRangeResponse:
+		return v.GetBody()
+	case *v2object.GetRangeHashResponse:
+		return v.GetBody()
+	case *v2object.DeleteResponse:
+		return v.GetBody()
+	case *v2object.SearchResponse:
+		return v.GetBody()
+	case *v2reputation.AnnounceLocalTrustResponse:
+		return v.GetBody()
+	case *v2reputation.AnnounceIntermediateResultResponse:
+		return v.GetBody()
+	case *v2session.CreateResponse:
+		return v.GetBody()
+	}
+}
+
+// checks the signature of the response part. Nil part is verified as
+// empty data.
+func verifyResponsePart(sig *refs.Signature, part stableMarshaler) error {
+	if sig == nil {
+		return errors.New("missing signature")
+	}
+
+	data, err := part.StableMarshal(nil)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	var s neofscrypto.Signature
+	s.ReadFromV2(*sig)
+
+	if !s.Verify(data) {
+		return errors.New("signature mismatch")
+	}
+
+	return nil
+}
+
+// verifies the response verification header chain. The procedure is
+// compatible with the one of VerifyServiceMessage function from
+// github.com/nspcc-dev/neofs-api-go/v2/signature package, but allows to find
+// out the failed part of the chain. If serverKey is set, the outermost level
+// of the chain must be signed with it.
+func verifyResponse(resp responseV2, serverKey []byte) error {
+	verify := resp.GetVerificationHeader()
+	if verify == nil {
+		return errors.New("missing verification header")
+	}
+
+	if serverKey != nil {
+		if key := verify.GetMetaSignature().GetKey(); !bytes.Equal(key, serverKey) {
+			return fmt.Errorf("unexpected server key %s", hex.EncodeToString(key))
+		}
+	}
+
+	body := responseBody(resp)
+	meta := resp.GetMetaHeader()
+
+	for level := 0; ; level++ {
+		err := verifyResponsePart(verify.GetMetaSignature(), meta)
+		if err != nil {
+			return fmt.Errorf("level %d: meta header: %w", level, err)
+		}
+
+		origin := verify.GetOrigin()
+
+		err = verifyResponsePart(verify.GetOriginSignature(), origin)
+		if err != nil {
+			return fmt.Errorf("level %d: origin verification header: %w", level, err)
+		}
+
+		if origin == nil {
+			// body is signed at the innermost level only
+			err = verifyResponsePart(verify.GetBodySignature(), body)
+			if err != nil {
+				return fmt.Errorf("level %d: body: %w", level, err)
+			}
+
+			return nil
+		}
+
+		if verify.GetBodySignature() != nil {
+			return fmt.Errorf("level %d: body signature at the non-innermost level", level)
+		}
+
+		verify, meta = origin, meta.GetOrigin()
+	}
+}
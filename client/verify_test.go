@@ -0,0 +1,172 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	signatureV2 "github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/stretchr/testify/require"
+)
+
+// testBalanceResponse returns response signed by the server key and forwarded
+// by the given number of the intermediate nodes.
+func testBalanceResponse(t *testing.T, server *keys.PrivateKey, forwards int) *v2accounting.BalanceResponse {
+	var body v2accounting.BalanceResponseBody
+	body.SetBalance(new(v2accounting.Decimal))
+
+	var meta v2session.ResponseMetaHeader
+	meta.SetEpoch(13)
+
+	var resp v2accounting.BalanceResponse
+	resp.SetBody(&body)
+	resp.SetMetaHeader(&meta)
+
+	require.NoError(t, signatureV2.SignServiceMessage(&server.PrivateKey, &resp))
+
+	for i := 0; i < forwards; i++ {
+		k, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		var meta v2session.ResponseMetaHeader
+		meta.SetOrigin(resp.GetMetaHeader())
+
+		resp.SetMetaHeader(&meta)
+
+		require.NoError(t, signatureV2.SignServiceMessage(&k.PrivateKey, &resp))
+	}
+
+	return &resp
+}
+
+func TestVerifyResponse(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		for _, forwards := range []int{0, 1, 2} {
+			resp := testBalanceResponse(t, k, forwards)
+			require.NoError(t, verifyResponse(resp, nil))
+		}
+	})
+
+	t.Run("missing verification header", func(t *testing.T) {
+		resp := testBalanceResponse(t, k, 0)
+		resp.SetVerificationHeader(nil)
+
+		require.Error(t, verifyResponse(resp, nil))
+	})
+
+	t.Run("body", func(t *testing.T) {
+		resp := testBalanceResponse(t, k, 1)
+		resp.GetBody().GetBalance().SetValue(1)
+
+		err := verifyResponse(resp, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "level 1: body")
+	})
+
+	t.Run("meta header", func(t *testing.T) {
+		resp := testBalanceResponse(t, k, 0)
+		resp.GetMetaHeader().SetEpoch(14)
+
+		err := verifyResponse(resp, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "level 0: meta header")
+
+		resp = testBalanceResponse(t, k, 1)
+		resp.GetMetaHeader().GetOrigin().SetEpoch(14)
+
+		err = verifyResponse(resp, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "level 0: meta header")
+	})
+
+	t.Run("origin verification header", func(t *testing.T) {
+		resp := testBalanceResponse(t, k, 2)
+		resp.GetVerificationHeader().GetOrigin().GetMetaSignature().SetSign([]byte("any"))
+
+		err := verifyResponse(resp, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "level 0: origin verification header")
+	})
+
+	t.Run("server key", func(t *testing.T) {
+		resp := testBalanceResponse(t, k, 0)
+		require.NoError(t, verifyResponse(resp, k.PublicKey().Bytes()))
+
+		other, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		err = verifyResponse(resp, other.PublicKey().Bytes())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected server key")
+
+		// response is forwarded by another node
+		resp = testBalanceResponse(t, k, 1)
+
+		err = verifyResponse(resp, k.PublicKey().Bytes())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unexpected server key")
+	})
+}
+
+func TestClient_ResponseVerification(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	other, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	call := func(t *testing.T, v ResponseVerification, serverKey []byte, resp *v2accounting.BalanceResponse) error {
+		var prm PrmInit
+		prm.SetResponseVerification(v)
+
+		cc, _ := testBalanceCall(t, prm)
+		cc.call = func() (responseV2, error) {
+			return resp, nil
+		}
+
+		if v == ResponseVerificationServerKey {
+			cc.serverKey = serverKey
+		}
+
+		cc.processCall()
+
+		return cc.err
+	}
+
+	corrupted := testBalanceResponse(t, k, 0)
+	corrupted.GetMetaHeader().SetEpoch(14)
+
+	t.Run("strict", func(t *testing.T) {
+		require.NoError(t, call(t, ResponseVerificationStrict, nil, testBalanceResponse(t, k, 0)))
+		require.Error(t, call(t, ResponseVerificationStrict, nil, corrupted))
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		require.NoError(t, call(t, ResponseVerificationSkip, nil, corrupted))
+	})
+
+	t.Run("server key", func(t *testing.T) {
+		serverKey := k.PublicKey().Bytes()
+
+		require.NoError(t, call(t, ResponseVerificationServerKey, serverKey, testBalanceResponse(t, k, 0)))
+		require.Error(t, call(t, ResponseVerificationServerKey, serverKey, testBalanceResponse(t, other, 0)))
+		require.Error(t, call(t, ResponseVerificationServerKey, serverKey, corrupted))
+	})
+
+	t.Run("missing server key", func(t *testing.T) {
+		var prm PrmInit
+		prm.SetResponseVerification(ResponseVerificationServerKey)
+
+		var c Client
+		c.Init(prm)
+
+		var prmDial PrmDial
+		prmDial.SetServerURI("localhost:8080")
+
+		require.Panics(t, func() { _ = c.Dial(prmDial) })
+	})
+}
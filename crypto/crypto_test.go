@@ -1,6 +1,8 @@
 package neofscrypto_test
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"math/rand"
 	"testing"
 
@@ -28,6 +30,9 @@ func TestSignature(t *testing.T) {
 		func() neofscrypto.Signer {
 			return neofsecdsa.SignerRFC6979(k.PrivateKey)
 		},
+		func() neofscrypto.Signer {
+			return neofsecdsa.SignerWalletConnect(k.PrivateKey)
+		},
 	} {
 		signer := f()
 
@@ -42,3 +47,49 @@ func TestSignature(t *testing.T) {
 		require.True(t, valid)
 	}
 }
+
+func TestSignature_WalletConnect(t *testing.T) {
+	// signatures are calculated without the SDK signers: the message is built
+	// the way Neo wallets do for the WalletConnect signMessage request, i.e.
+	// 0x010001f0 prefix, var-uint length, hex-encoded salt followed by the data
+	// and 0x0000 suffix, and then signed by neo-go key
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	salt := make([]byte, 16)
+	rand.Read(salt)
+
+	for _, data := range [][]byte{
+		{},
+		[]byte("Hello, world!"),
+		{0x0a, 0x03, 0x01, 0x02, 0x03},
+	} {
+		salted := hex.EncodeToString(salt) + base64.StdEncoding.EncodeToString(data)
+
+		msg := append([]byte{0x01, 0x00, 0x01, 0xf0, byte(len(salted))}, salted...)
+		msg = append(msg, 0x00, 0x00)
+
+		bSig := append(k.Sign(msg), salt...)
+
+		var m refs.Signature
+		m.SetKey(k.PublicKey().Bytes())
+		m.SetSign(bSig)
+		m.SetScheme(refs.SignatureScheme(neofscrypto.ECDSA_WALLETCONNECT))
+
+		var s neofscrypto.Signature
+		s.ReadFromV2(m)
+
+		require.True(t, s.Verify(data))
+		require.False(t, s.Verify(append(data, 1)))
+
+		// corrupted salt
+		bSig[len(bSig)-1]++
+		require.False(t, s.Verify(data))
+
+		// the signature is verified with the scheme only
+		bSig[len(bSig)-1]--
+		m.SetScheme(refs.SignatureScheme(neofscrypto.ECDSA_DETERMINISTIC_SHA256))
+		s.ReadFromV2(m)
+		require.False(t, s.Verify(data))
+	}
+}
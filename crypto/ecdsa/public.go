@@ -124,3 +124,55 @@ func (x PublicKeyRFC6979) Verify(data, signature []byte) bool {
 	h := sha256.Sum256(data)
 	return (*keys.PublicKey)(&x).Verify(signature, h[:])
 }
+
+// PublicKeyWalletConnect is a wrapper over ecdsa.PublicKey used for NeoFS needs.
+// Provides neofscrypto.PublicKey interface.
+//
+// Instances MUST be initialized from ecdsa.PublicKey using type conversion.
+type PublicKeyWalletConnect ecdsa.PublicKey
+
+// MaxEncodedSize returns size of the compressed ECDSA public key.
+func (x PublicKeyWalletConnect) MaxEncodedSize() int {
+	return 33
+}
+
+// Encode encodes ECDSA public key in compressed form into buf.
+// Uses exactly MaxEncodedSize bytes of the buf.
+//
+// Encode panics if buf length is less than MaxEncodedSize.
+//
+// See also Decode.
+func (x PublicKeyWalletConnect) Encode(buf []byte) int {
+	if len(buf) < 33 {
+		panic(fmt.Sprintf("too short buffer %d", len(buf)))
+	}
+
+	return copy(buf, (*keys.PublicKey)(&x).Bytes())
+}
+
+// Decode decodes binary representation of the ECDSA public key.
+//
+// See also Encode.
+func (x *PublicKeyWalletConnect) Decode(data []byte) error {
+	pub, err := keys.NewPublicKeyFromBytes(data, elliptic.P256())
+	if err != nil {
+		return err
+	}
+
+	*x = (PublicKeyWalletConnect)(*pub)
+
+	return nil
+}
+
+// Verify verifies data signature calculated by deterministic ECDSA algorithm
+// with SHA-256 hashing according to the Wallet Connect. The signature must be
+// followed by the 16-byte salt.
+func (x PublicKeyWalletConnect) Verify(data, signature []byte) bool {
+	if len(signature) != keys.SignatureLen+saltLen {
+		return false
+	}
+
+	h := sha256.Sum256(saltMessageWalletConnect(data, signature[keys.SignatureLen:]))
+
+	return (*keys.PublicKey)(&x).Verify(signature[:keys.SignatureLen], h[:])
+}
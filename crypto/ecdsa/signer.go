@@ -5,6 +5,7 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha512"
+	"fmt"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
@@ -66,3 +67,40 @@ func (x SignerRFC6979) Sign(data []byte) ([]byte, error) {
 func (x SignerRFC6979) Public() neofscrypto.PublicKey {
 	return (*PublicKeyRFC6979)(&x.PublicKey)
 }
+
+// SignerWalletConnect wraps ecdsa.PrivateKey and represents signer based on
+// deterministic ECDSA with SHA-256 hashing (RFC 6979) applied to the salted
+// message formed according to the Wallet Connect. Provides neofscrypto.Signer
+// interface.
+//
+// Instances SHOULD be initialized from ecdsa.PrivateKey using type conversion.
+type SignerWalletConnect ecdsa.PrivateKey
+
+// Scheme returns neofscrypto.ECDSA_WALLETCONNECT.
+// Implements neofscrypto.Signer.
+func (x SignerWalletConnect) Scheme() neofscrypto.Scheme {
+	return neofscrypto.ECDSA_WALLETCONNECT
+}
+
+// Sign signs data using deterministic ECDSA algorithm with SHA-256 hashing
+// according to the Wallet Connect. Resulting signature is followed by the
+// random 16-byte salt.
+// Implements neofscrypto.Signer.
+func (x SignerWalletConnect) Sign(data []byte) ([]byte, error) {
+	var salt [saltLen]byte
+
+	_, err := rand.Read(salt[:])
+	if err != nil {
+		return nil, fmt.Errorf("generate salt: %w", err)
+	}
+
+	p := keys.PrivateKey{PrivateKey: (ecdsa.PrivateKey)(x)}
+
+	return append(p.Sign(saltMessageWalletConnect(data, salt[:])), salt[:]...), nil
+}
+
+// Public initializes PublicKeyWalletConnect and returns it as neofscrypto.PublicKey.
+// Implements neofscrypto.Signer.
+func (x SignerWalletConnect) Public() neofscrypto.PublicKey {
+	return (*PublicKeyWalletConnect)(&x.PublicKey)
+}
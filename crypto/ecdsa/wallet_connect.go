@@ -0,0 +1,57 @@
+package neofsecdsa
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// saltLen is a length of the random salt appended to the Wallet Connect
+// signatures.
+const saltLen = 16
+
+// prefix and suffix of the messages signed according to the Wallet Connect.
+var (
+	walletConnectPrefix = []byte{0x01, 0x00, 0x01, 0xf0}
+	walletConnectSuffix = []byte{0x00, 0x00}
+)
+
+// saltMessageWalletConnect returns message signed according to the Wallet
+// Connect for the given data and salt. The data is base64-encoded first, and
+// then salted with the hex-encoded salt. The resulting message consists of the
+// fixed prefix, var-uint length of the salted data, the salted data itself and
+// the fixed suffix.
+func saltMessageWalletConnect(data, salt []byte) []byte {
+	saltedLen := hex.EncodedLen(len(salt)) + base64.StdEncoding.EncodedLen(len(data))
+
+	b := make([]byte, 0, len(walletConnectPrefix)+9+saltedLen+len(walletConnectSuffix))
+	b = append(b, walletConnectPrefix...)
+	b = appendVarUint(b, uint64(saltedLen))
+
+	n := len(b)
+	b = b[:n+saltedLen]
+	n += hex.Encode(b[n:], salt)
+	base64.StdEncoding.Encode(b[n:], data)
+
+	return append(b, walletConnectSuffix...)
+}
+
+// appendVarUint appends variable-length unsigned integer encoded according to
+// the Neo binary serialization format.
+func appendVarUint(b []byte, v uint64) []byte {
+	var buf [8]byte
+
+	switch {
+	case v < 0xfd:
+		return append(b, byte(v))
+	case v <= 0xffff:
+		binary.LittleEndian.PutUint16(buf[:], uint16(v))
+		return append(append(b, 0xfd), buf[:2]...)
+	case v <= 0xffffffff:
+		binary.LittleEndian.PutUint32(buf[:], uint32(v))
+		return append(append(b, 0xfe), buf[:4]...)
+	default:
+		binary.LittleEndian.PutUint64(buf[:], v)
+		return append(append(b, 0xff), buf[:]...)
+	}
+}
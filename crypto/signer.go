@@ -17,6 +17,7 @@ const (
 
 	ECDSA_SHA512               // ECDSA with SHA-512 hashing (FIPS 186-3)
 	ECDSA_DETERMINISTIC_SHA256 // Deterministic ECDSA with SHA-256 hashing (RFC 6979)
+	ECDSA_WALLETCONNECT        // Wallet Connect signature scheme
 )
 
 // String implements fmt.Stringer.
@@ -0,0 +1,13 @@
+/*
+Package neofscryptotest provides functions for convenient testing of neofscrypto package API.
+
+Note that importing the package into source files is highly discouraged.
+
+Random instance generation functions can be useful when testing expects any value, e.g.:
+	import neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+
+	signer := neofscryptotest.Signer()
+	// test the value
+
+*/
+package neofscryptotest
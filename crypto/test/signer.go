@@ -0,0 +1,39 @@
+package neofscryptotest
+
+import (
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+)
+
+// Signer returns neofscrypto.Signer based on random ECDSA private key with
+// SHA-512 hashing.
+func Signer() neofscrypto.Signer {
+	k, err := keys.NewPrivateKey()
+	if err != nil {
+		panic(err)
+	}
+
+	return neofsecdsa.Signer(k.PrivateKey)
+}
+
+// failSigner is a neofscrypto.Signer which fails to sign any data.
+type failSigner struct {
+	neofscrypto.Signer
+
+	err error
+}
+
+// Sign returns the configured error.
+func (x failSigner) Sign([]byte) ([]byte, error) {
+	return nil, x.err
+}
+
+// FailSigner returns neofscrypto.Signer with random public key which fails
+// to sign any data with the given error.
+func FailSigner(err error) neofscrypto.Signer {
+	return failSigner{
+		Signer: Signer(),
+		err:    err,
+	}
+}
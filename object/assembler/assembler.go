@@ -0,0 +1,525 @@
+package assembler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+var (
+	errMissingParent     = errors.New("missing parent header")
+	errChecksumMismatch  = errors.New("payload checksum mismatch")
+	errChecksumNotSet    = errors.New("payload checksum is not set")
+	errBrokenChain       = errors.New("broken chain of child objects")
+	errNothingToAssemble = errors.New("split info has neither link, last part nor split ID")
+)
+
+// Assembler reconstructs the split objects from the physically stored children.
+// Working Assembler must be constructed via New.
+type Assembler struct {
+	src ObjectSource
+}
+
+// New constructs Assembler which reads the objects from the given source.
+func New(src ObjectSource) *Assembler {
+	return &Assembler{src: src}
+}
+
+// Assemble resolves the children of the split object from the container
+// according to the split info and opens the stream of the full object payload.
+// Each child's payload is checked against the child header, the full payload
+// is checked against the parent header at the end of the stream.
+//
+// Resulting Reader must be finally closed.
+func (x *Assembler) Assemble(ctx context.Context, cnr cid.ID, si object.SplitInfo) (*Reader, error) {
+	parent, children, err := x.resolve(ctx, cnr, si)
+	if err != nil {
+		return nil, err
+	}
+
+	r := x.newReader(ctx, cnr, parent)
+	r.fullHash = sha256.New()
+
+	for i := range children {
+		if sz := children[i].PayloadSize(); sz > 0 {
+			r.parts = append(r.parts, part{hdr: children[i], ln: sz, full: true})
+		}
+	}
+
+	return r, nil
+}
+
+// AssembleRange works like Assemble but opens the stream of the payload range
+// of the split object. ln must be positive. Only the children read in full are
+// checked against their headers.
+//
+// Resulting Reader must be finally closed.
+func (x *Assembler) AssembleRange(ctx context.Context, cnr cid.ID, si object.SplitInfo, off, ln uint64) (*Reader, error) {
+	if ln == 0 {
+		return nil, errors.New("zero range length")
+	}
+
+	parent, children, err := x.resolve(ctx, cnr, si)
+	if err != nil {
+		return nil, err
+	}
+
+	if off+ln < off || off+ln > parent.PayloadSize() {
+		return nil, fmt.Errorf("range [%d:%d] is out of payload bounds (%d)", off, off+ln, parent.PayloadSize())
+	}
+
+	r := x.newReader(ctx, cnr, parent)
+
+	var childOff uint64
+
+	for i := range children {
+		sz := children[i].PayloadSize()
+		childEnd := childOff + sz
+
+		if childEnd > off && childOff < off+ln {
+			from := maxUint64(off, childOff) - childOff
+			to := minUint64(off+ln, childEnd) - childOff
+
+			r.parts = append(r.parts, part{
+				hdr:  children[i],
+				off:  from,
+				ln:   to - from,
+				full: from == 0 && to == sz,
+			})
+		}
+
+		childOff = childEnd
+	}
+
+	return r, nil
+}
+
+// resolve returns parent header and ordered list of child headers.
+func (x *Assembler) resolve(ctx context.Context, cnr cid.ID, si object.SplitInfo) (*object.Object, []*object.Object, error) {
+	var errs []error
+
+	if link, ok := si.Link(); ok {
+		parent, children, err := x.resolveByLink(ctx, cnr, link)
+		if err == nil {
+			return parent, children, nil
+		}
+
+		errs = append(errs, fmt.Errorf("resolve via linking object: %w", err))
+	}
+
+	if last, ok := si.LastPart(); ok {
+		parent, children, err := x.resolveByLastPart(ctx, cnr, last)
+		if err == nil {
+			return parent, children, nil
+		}
+
+		errs = append(errs, fmt.Errorf("resolve via last part: %w", err))
+	}
+
+	if splitID := si.SplitID(); splitID != nil {
+		parent, children, err := x.resolveBySplitID(ctx, cnr, splitID)
+		if err == nil {
+			return parent, children, nil
+		}
+
+		errs = append(errs, fmt.Errorf("resolve via split ID: %w", err))
+	}
+
+	if len(errs) == 0 {
+		return nil, nil, errNothingToAssemble
+	}
+
+	// the last failure is the most significant one since previous
+	// methods have been tried first
+	return nil, nil, errs[len(errs)-1]
+}
+
+func (x *Assembler) head(ctx context.Context, cnr cid.ID, id oid.ID) (*object.Object, error) {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(id)
+
+	hdr, err := x.src.Head(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("read header of the object %s: %w", id, err)
+	}
+
+	// response to HEAD request doesn't carry object ID
+	hdr.SetID(id)
+
+	return hdr, nil
+}
+
+func (x *Assembler) resolveByLink(ctx context.Context, cnr cid.ID, link oid.ID) (*object.Object, []*object.Object, error) {
+	hdr, err := x.head(ctx, cnr, link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := hdr.Parent()
+	if parent == nil {
+		return nil, nil, errMissingParent
+	}
+
+	ids := hdr.Children()
+	if len(ids) == 0 {
+		return nil, nil, errors.New("empty list of children in linking object")
+	}
+
+	children := make([]*object.Object, len(ids))
+
+	for i := range ids {
+		children[i], err = x.head(ctx, cnr, ids[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return parent, children, checkChildren(parent, children)
+}
+
+func (x *Assembler) resolveByLastPart(ctx context.Context, cnr cid.ID, last oid.ID) (*object.Object, []*object.Object, error) {
+	hdr, err := x.head(ctx, cnr, last)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := hdr.Parent()
+	if parent == nil {
+		return nil, nil, errMissingParent
+	}
+
+	children := []*object.Object{hdr}
+	visited := map[oid.ID]struct{}{last: {}}
+
+	for {
+		prev, ok := hdr.PreviousID()
+		if !ok {
+			break
+		}
+
+		if _, ok = visited[prev]; ok {
+			return nil, nil, errBrokenChain
+		}
+
+		visited[prev] = struct{}{}
+
+		hdr, err = x.head(ctx, cnr, prev)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		children = append(children, hdr)
+	}
+
+	// children were collected in reverse order
+	for i, j := 0, len(children)-1; i < j; i, j = i+1, j-1 {
+		children[i], children[j] = children[j], children[i]
+	}
+
+	return parent, children, checkChildren(parent, children)
+}
+
+func (x *Assembler) resolveBySplitID(ctx context.Context, cnr cid.ID, splitID *object.SplitID) (*object.Object, []*object.Object, error) {
+	var filters object.SearchFilters
+	filters.AddPhyFilter()
+	filters.AddSplitIDFilter(object.MatchStringEqual, splitID)
+
+	ids, err := x.src.Search(ctx, cnr, filters)
+	if err != nil {
+		return nil, nil, fmt.Errorf("search objects by split ID: %w", err)
+	}
+
+	var (
+		parent *object.Object
+		first  *object.Object
+		count  int
+		next   = make(map[oid.ID]*object.Object, len(ids))
+	)
+
+	for i := range ids {
+		hdr, err := x.head(ctx, cnr, ids[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(hdr.Children()) > 0 {
+			// skip linking object
+			continue
+		}
+
+		if p := hdr.Parent(); p != nil && parent == nil {
+			parent = p
+		}
+
+		count++
+
+		if prev, ok := hdr.PreviousID(); ok {
+			next[prev] = hdr
+		} else if first == nil {
+			first = hdr
+		} else {
+			return nil, nil, errBrokenChain
+		}
+	}
+
+	if parent == nil {
+		return nil, nil, errMissingParent
+	}
+
+	if first == nil {
+		return nil, nil, errBrokenChain
+	}
+
+	children := make([]*object.Object, 0, count)
+
+	for hdr := first; hdr != nil; {
+		children = append(children, hdr)
+
+		id, _ := hdr.ID()
+		hdr = next[id]
+
+		if len(children) > count {
+			return nil, nil, errBrokenChain
+		}
+	}
+
+	if len(children) != count {
+		return nil, nil, errBrokenChain
+	}
+
+	return parent, children, checkChildren(parent, children)
+}
+
+// checkChildren checks that the children compose the parent payload.
+func checkChildren(parent *object.Object, children []*object.Object) error {
+	var sz uint64
+
+	for i := range children {
+		sz += children[i].PayloadSize()
+	}
+
+	if sz != parent.PayloadSize() {
+		return fmt.Errorf("%w: children payload size %d differs from the parent one %d",
+			errBrokenChain, sz, parent.PayloadSize())
+	}
+
+	return nil
+}
+
+func (x *Assembler) newReader(ctx context.Context, cnr cid.ID, parent *object.Object) *Reader {
+	return &Reader{
+		ctx:    ctx,
+		src:    x.src,
+		cnr:    cnr,
+		parent: parent,
+	}
+}
+
+// part describes payload section of the single child object.
+type part struct {
+	hdr *object.Object
+
+	off, ln uint64
+
+	// part covers full payload of the child
+	full bool
+}
+
+// Reader reads the payload of the split object assembled from its children.
+//
+// Must be initialized using Assembler.Assemble or Assembler.AssembleRange,
+// any other usage is unsafe.
+type Reader struct {
+	ctx context.Context
+
+	src ObjectSource
+
+	cnr cid.ID
+
+	parent *object.Object
+
+	parts []part
+
+	// index of the current part
+	i int
+
+	// stream of the current part
+	cur io.ReadCloser
+
+	// number of bytes left in the current part
+	remaining uint64
+
+	// hash of the current part, set for full parts only
+	partHash hash.Hash
+
+	// hash of the full payload, set by Assemble only
+	fullHash hash.Hash
+}
+
+// Header returns header of the assembled object.
+func (x *Reader) Header() object.Object {
+	return *x.parent
+}
+
+// Read implements io.Reader of the object payload. Returns io.EOF when the
+// payload is finished and successfully verified.
+func (x *Reader) Read(p []byte) (int, error) {
+	for {
+		if x.cur == nil {
+			if x.i == len(x.parts) {
+				if x.fullHash != nil {
+					if err := verifySum(*x.parent, x.fullHash); err != nil {
+						return 0, fmt.Errorf("verify parent payload: %w", err)
+					}
+
+					x.fullHash = nil
+				}
+
+				return 0, io.EOF
+			}
+
+			if err := x.openPart(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := x.cur.Read(p)
+		if n > 0 {
+			if uint64(n) > x.remaining {
+				return 0, fmt.Errorf("child object %d: payload size overflow", x.i)
+			}
+
+			x.remaining -= uint64(n)
+
+			if x.partHash != nil {
+				x.partHash.Write(p[:n])
+			}
+
+			if x.fullHash != nil {
+				x.fullHash.Write(p[:n])
+			}
+		}
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			return n, fmt.Errorf("read child object %d: %w", x.i, err)
+		}
+
+		if errors.Is(err, io.EOF) || x.remaining == 0 {
+			if err := x.finishPart(); err != nil {
+				return n, err
+			}
+		}
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+func (x *Reader) openPart() error {
+	p := x.parts[x.i]
+
+	id, _ := p.hdr.ID()
+
+	var addr oid.Address
+	addr.SetContainer(x.cnr)
+	addr.SetObject(id)
+
+	var err error
+
+	if p.full {
+		x.cur, err = x.src.Payload(x.ctx, addr)
+		x.partHash = sha256.New()
+	} else {
+		x.cur, err = x.src.Range(x.ctx, addr, p.off, p.ln)
+		x.partHash = nil
+	}
+
+	if err != nil {
+		x.cur = nil
+		return fmt.Errorf("open payload of the child object %s: %w", id, err)
+	}
+
+	x.remaining = p.ln
+
+	return nil
+}
+
+func (x *Reader) finishPart() error {
+	err := x.cur.Close()
+	x.cur = nil
+
+	if err != nil {
+		return fmt.Errorf("close child object %d: %w", x.i, err)
+	}
+
+	if x.remaining > 0 {
+		return fmt.Errorf("child object %d: %w", x.i, io.ErrUnexpectedEOF)
+	}
+
+	if x.partHash != nil {
+		if err = verifySum(*x.parts[x.i].hdr, x.partHash); err != nil {
+			return fmt.Errorf("verify child object %d: %w", x.i, err)
+		}
+	}
+
+	x.i++
+
+	return nil
+}
+
+// Close closes the stream of the current child object. Must be called after
+// using the Reader.
+func (x *Reader) Close() error {
+	if x.cur == nil {
+		return nil
+	}
+
+	err := x.cur.Close()
+	x.cur = nil
+
+	return err
+}
+
+func verifySum(hdr object.Object, h hash.Hash) error {
+	cs, ok := hdr.PayloadChecksum()
+	if !ok {
+		return errChecksumNotSet
+	}
+
+	if cs.Type() != checksum.SHA256 {
+		return fmt.Errorf("unsupported payload checksum type %s", cs.Type())
+	}
+
+	if !bytes.Equal(cs.Value(), h.Sum(nil)) {
+		return errChecksumMismatch
+	}
+
+	return nil
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,222 @@
+package assembler_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/assembler"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStream struct {
+	bytes.Buffer
+
+	s   *memorySource
+	hdr object.Object
+}
+
+func (x *memoryStream) Close() error {
+	id, _ := x.hdr.ID()
+	x.hdr.SetPayload(x.Bytes())
+	x.s.objects[id] = x.hdr
+	x.s.order = append(x.s.order, id)
+	return nil
+}
+
+// memorySource implements both slicer.ObjectWriter and assembler.ObjectSource.
+type memorySource struct {
+	objects map[oid.ID]object.Object
+	order   []oid.ID
+}
+
+func newMemorySource() *memorySource {
+	return &memorySource{objects: make(map[oid.ID]object.Object)}
+}
+
+func (x *memorySource) InitDataStream(hdr object.Object) (io.WriteCloser, error) {
+	return &memoryStream{s: x, hdr: hdr}, nil
+}
+
+func (x *memorySource) get(addr oid.Address) (object.Object, error) {
+	obj, ok := x.objects[addr.Object()]
+	if !ok {
+		return object.Object{}, apistatus.ObjectNotFound{}
+	}
+
+	return obj, nil
+}
+
+func (x *memorySource) Head(_ context.Context, addr oid.Address) (*object.Object, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.CutPayload(), nil
+}
+
+func (x *memorySource) Payload(_ context.Context, addr oid.Address) (io.ReadCloser, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(obj.Payload())), nil
+}
+
+func (x *memorySource) Range(_ context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(obj.Payload()[off : off+ln])), nil
+}
+
+func (x *memorySource) Search(_ context.Context, _ cid.ID, filters object.SearchFilters) ([]oid.ID, error) {
+	values := make(map[string]struct{}, len(filters))
+
+	for i := range filters {
+		values[filters[i].Value()] = struct{}{}
+	}
+
+	var res []oid.ID
+
+	for _, id := range x.order {
+		obj, ok := x.objects[id]
+		if !ok || obj.SplitID() == nil {
+			continue
+		}
+
+		if _, ok = values[obj.SplitID().String()]; ok {
+			res = append(res, id)
+		}
+	}
+
+	return res, nil
+}
+
+func sliceObject(t *testing.T, src *memorySource, payload []byte) (oid.ID, object.SplitInfo) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(1 << 10)
+
+	var hdr object.Object
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(usertest.ID())
+
+	id, err := slicer.New(k.PrivateKey, src, opts).Slice(hdr, bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	linkID := src.order[len(src.order)-1]
+	lastID := src.order[len(src.order)-2]
+	link := src.objects[linkID]
+
+	var si object.SplitInfo
+	si.SetSplitID(link.SplitID())
+	si.SetLink(linkID)
+	si.SetLastPart(lastID)
+
+	return id, si
+}
+
+func readAll(t *testing.T, r *assembler.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, r.Close())
+	return data, err
+}
+
+func TestAssembler_Assemble(t *testing.T) {
+	payload := make([]byte, 5<<10+17)
+	_, _ = rand.Read(payload)
+
+	src := newMemorySource()
+	id, si := sliceObject(t, src, payload)
+	cnr := cidtest.ID()
+
+	t.Run("via link", func(t *testing.T) {
+		r, err := assembler.New(src).Assemble(context.Background(), cnr, si)
+		require.NoError(t, err)
+
+		hdr := r.Header()
+		parentID, _ := hdr.ID()
+		require.Equal(t, id, parentID)
+
+		data, err := readAll(t, r)
+		require.NoError(t, err)
+		require.Equal(t, payload, data)
+	})
+
+	t.Run("via last part", func(t *testing.T) {
+		var siLast object.SplitInfo
+		lastID, _ := si.LastPart()
+		siLast.SetLastPart(lastID)
+
+		r, err := assembler.New(src).Assemble(context.Background(), cnr, siLast)
+		require.NoError(t, err)
+
+		data, err := readAll(t, r)
+		require.NoError(t, err)
+		require.Equal(t, payload, data)
+	})
+
+	t.Run("via split ID", func(t *testing.T) {
+		var siSplit object.SplitInfo
+		siSplit.SetSplitID(si.SplitID())
+
+		r, err := assembler.New(src).Assemble(context.Background(), cnr, siSplit)
+		require.NoError(t, err)
+
+		data, err := readAll(t, r)
+		require.NoError(t, err)
+		require.Equal(t, payload, data)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		const off, ln = 1000, 3000
+
+		r, err := assembler.New(src).AssembleRange(context.Background(), cnr, si, off, ln)
+		require.NoError(t, err)
+
+		data, err := readAll(t, r)
+		require.NoError(t, err)
+		require.Equal(t, payload[off:off+ln], data)
+
+		_, err = assembler.New(src).AssembleRange(context.Background(), cnr, si, uint64(len(payload)), 1)
+		require.Error(t, err)
+	})
+
+	t.Run("corrupted child", func(t *testing.T) {
+		corrupted := newMemorySource()
+		_, si := sliceObject(t, corrupted, payload)
+
+		childID := corrupted.order[1]
+		child := corrupted.objects[childID]
+		child.Payload()[0]++
+
+		r, err := assembler.New(corrupted).Assemble(context.Background(), cnr, si)
+		require.NoError(t, err)
+
+		_, err = readAll(t, r)
+		require.Error(t, err)
+	})
+
+	t.Run("missing objects", func(t *testing.T) {
+		_, err := assembler.New(newMemorySource()).Assemble(context.Background(), cnr, si)
+		require.Error(t, err)
+	})
+}
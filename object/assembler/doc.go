@@ -0,0 +1,33 @@
+/*
+Package assembler provides reconstruction of the large NeoFS objects from their
+physically stored parts.
+
+Storage nodes respond with object.SplitInfoError to the raw requests of the
+objects which were split into several children. Assembler resolves the chain of
+child objects using object.SplitInfo: through the linking object, by walking the
+previous object IDs backwards starting from the last part or, if both are
+unavailable, by searching the objects with the same split ID.
+
+Read the full payload of the split object:
+	_, err := c.ObjectHead(ctx, prmHeadRaw)
+
+	var errSplit *object.SplitInfoError
+	if errors.As(err, &errSplit) {
+		a := assembler.New(assembler.NewClientSource(&c))
+
+		r, err := a.Assemble(ctx, cnr, *errSplit.SplitInfo())
+		// ...
+
+		_, err = io.Copy(dst, r)
+		// ...
+
+		err = r.Close()
+		// ...
+	}
+
+Each child's payload is checked against its header, and the full payload is
+checked against the parent checksum at the end of the stream. Payload ranges
+can be read using AssembleRange.
+
+*/
+package assembler
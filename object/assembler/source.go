@@ -0,0 +1,157 @@
+package assembler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ObjectSource represents a source of the physically stored objects.
+// All operations are expected to be executed in the raw mode: they must not
+// assemble the objects on their own.
+type ObjectSource interface {
+	// Head reads header of the object.
+	Head(ctx context.Context, addr oid.Address) (*object.Object, error)
+
+	// Payload opens the stream of the object payload.
+	// Resulting reader must be finally closed.
+	Payload(ctx context.Context, addr oid.Address) (io.ReadCloser, error)
+
+	// Range opens the stream of the object payload range. ln is always positive.
+	// Resulting reader must be finally closed.
+	Range(ctx context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error)
+
+	// Search selects identifiers of the objects from the container which match
+	// the filters.
+	Search(ctx context.Context, cnr cid.ID, filters object.SearchFilters) ([]oid.ID, error)
+}
+
+// ClientSource is an ObjectSource which reads objects through the NeoFS API
+// client. Must be constructed via NewClientSource.
+type ClientSource struct {
+	c *client.Client
+}
+
+// NewClientSource constructs ClientSource which reads physically stored objects
+// via given Client.
+func NewClientSource(c *client.Client) *ClientSource {
+	return &ClientSource{c: c}
+}
+
+// Head reads object header using client.Client.ObjectHead. Implements ObjectSource.
+func (x *ClientSource) Head(ctx context.Context, addr oid.Address) (*object.Object, error) {
+	var prm client.PrmObjectHead
+	prm.MarkRaw()
+	prm.FromContainer(addr.Container())
+	prm.ByID(addr.Object())
+
+	res, err := x.c.ObjectHead(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj object.Object
+
+	if !res.ReadHeader(&obj) {
+		return nil, errors.New("missing object header in response")
+	}
+
+	return &obj, nil
+}
+
+// Payload opens the payload stream using client.Client.ObjectGetInit.
+// Implements ObjectSource.
+func (x *ClientSource) Payload(ctx context.Context, addr oid.Address) (io.ReadCloser, error) {
+	var prm client.PrmObjectGet
+	prm.MarkRaw()
+	prm.FromContainer(addr.Container())
+	prm.ByID(addr.Object())
+
+	r, err := x.c.ObjectGetInit(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	var hdr object.Object
+
+	if !r.ReadHeader(&hdr) {
+		_, err = r.Close()
+		if err == nil {
+			err = errors.New("missing object header in response")
+		}
+
+		return nil, err
+	}
+
+	return (*objectReader)(r), nil
+}
+
+// Range opens the payload range stream using client.Client.ObjectRangeInit.
+// Implements ObjectSource.
+func (x *ClientSource) Range(ctx context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error) {
+	var prm client.PrmObjectRange
+	prm.MarkRaw()
+	prm.FromContainer(addr.Container())
+	prm.ByID(addr.Object())
+	prm.SetOffset(off)
+	prm.SetLength(ln)
+
+	r, err := x.c.ObjectRangeInit(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*rangeReader)(r), nil
+}
+
+// Search selects the objects using client.Client.ObjectSearchInit.
+// Implements ObjectSource.
+func (x *ClientSource) Search(ctx context.Context, cnr cid.ID, filters object.SearchFilters) ([]oid.ID, error) {
+	var prm client.PrmObjectSearch
+	prm.InContainer(cnr)
+	prm.SetFilters(filters)
+
+	r, err := x.c.ObjectSearchInit(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []oid.ID
+
+	err = r.Iterate(func(id oid.ID) bool {
+		res = append(res, id)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type objectReader client.ObjectReader
+
+func (x *objectReader) Read(p []byte) (int, error) {
+	return (*client.ObjectReader)(x).Read(p)
+}
+
+func (x *objectReader) Close() error {
+	_, err := (*client.ObjectReader)(x).Close()
+	return err
+}
+
+type rangeReader client.ObjectRangeReader
+
+func (x *rangeReader) Read(p []byte) (int, error) {
+	return (*client.ObjectRangeReader)(x).Read(p)
+}
+
+func (x *rangeReader) Close() error {
+	_, err := (*client.ObjectRangeReader)(x).Close()
+	return err
+}
@@ -6,10 +6,12 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
@@ -28,6 +30,70 @@ func CalculatePayloadChecksum(payload []byte) checksum.Checksum {
 	return res
 }
 
+// PayloadHashWriter calculates checksums of the object payload while the
+// payload streams through it, so the payload does not need to be held in memory.
+//
+// Instances must be created using NewPayloadHashWriter.
+type PayloadHashWriter struct {
+	w io.Writer
+
+	size uint64
+
+	hashSHA *checksum.Hasher
+
+	hashTZ *checksum.Hasher
+}
+
+// NewPayloadHashWriter returns PayloadHashWriter which passes the payload to
+// the given writer. Nil writer means that the payload is only hashed. The
+// homomorphic hash is calculated if corresponding flag is set.
+func NewPayloadHashWriter(w io.Writer, homomorphic bool) *PayloadHashWriter {
+	res := &PayloadHashWriter{
+		w:       w,
+		hashSHA: checksum.NewHasher(checksum.SHA256),
+	}
+
+	if homomorphic {
+		res.hashTZ = checksum.NewHasher(checksum.TZ)
+	}
+
+	return res
+}
+
+// Write writes the payload chunk to the underlying writer and hashes the
+// written bytes. Implements io.Writer.
+func (x *PayloadHashWriter) Write(p []byte) (int, error) {
+	var err error
+
+	if x.w != nil {
+		var n int
+
+		n, err = x.w.Write(p)
+		p = p[:n]
+	}
+
+	x.hashSHA.Write(p)
+
+	if x.hashTZ != nil {
+		x.hashTZ.Write(p)
+	}
+
+	x.size += uint64(len(p))
+
+	return len(p), err
+}
+
+// WriteChecksums writes the size, checksum and homomorphic hash (if calculated)
+// of the payload written so far to the object header.
+func (x *PayloadHashWriter) WriteChecksums(obj *Object) {
+	obj.SetPayloadSize(x.size)
+	obj.SetPayloadChecksum(x.hashSHA.Checksum())
+
+	if x.hashTZ != nil {
+		obj.SetPayloadHomomorphicHash(x.hashTZ.Checksum())
+	}
+}
+
 // CalculateAndSetPayloadChecksum calculates checksum of current
 // object payload and writes it to the object.
 func CalculateAndSetPayloadChecksum(obj *Object) {
@@ -101,13 +167,28 @@ func VerifyID(obj *Object) error {
 
 // CalculateAndSetSignature signs id with provided key and sets that signature to
 // the object.
+//
+// See also CalculateAndSetSignatureWithSigner.
 func CalculateAndSetSignature(key ecdsa.PrivateKey, obj *Object) error {
+	return CalculateAndSetSignatureWithSigner(neofsecdsa.Signer(key), obj)
+}
+
+// CalculateAndSetSignatureWithSigner signs id with provided signer and sets
+// that signature to the object.
+func CalculateAndSetSignatureWithSigner(signer neofscrypto.Signer, obj *Object) error {
 	oID, set := obj.ID()
 	if !set {
 		return errOIDNotSet
 	}
 
-	sig, err := oID.CalculateIDSignature(key)
+	data, err := oID.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshal ID: %w", err)
+	}
+
+	var sig neofscrypto.Signature
+
+	err = sig.Calculate(signer, data)
 	if err != nil {
 		return err
 	}
@@ -143,12 +224,20 @@ func (o *Object) VerifyIDSignature() bool {
 }
 
 // SetIDWithSignature sets object identifier and signature.
+//
+// See also SetIDWithSigner.
 func SetIDWithSignature(key ecdsa.PrivateKey, obj *Object) error {
+	return SetIDWithSigner(neofsecdsa.Signer(key), obj)
+}
+
+// SetIDWithSigner sets object identifier and its signature calculated using
+// provided signer.
+func SetIDWithSigner(signer neofscrypto.Signer, obj *Object) error {
 	if err := CalculateAndSetID(obj); err != nil {
 		return fmt.Errorf("could not set identifier: %w", err)
 	}
 
-	if err := CalculateAndSetSignature(key, obj); err != nil {
+	if err := CalculateAndSetSignatureWithSigner(signer, obj); err != nil {
 		return fmt.Errorf("could not set signature: %w", err)
 	}
 
@@ -156,10 +245,18 @@ func SetIDWithSignature(key ecdsa.PrivateKey, obj *Object) error {
 }
 
 // SetVerificationFields calculates and sets all verification fields of the object.
+//
+// See also SetVerificationFieldsWithSigner.
 func SetVerificationFields(key ecdsa.PrivateKey, obj *Object) error {
+	return SetVerificationFieldsWithSigner(neofsecdsa.Signer(key), obj)
+}
+
+// SetVerificationFieldsWithSigner calculates and sets all verification fields
+// of the object. Object is signed using provided signer.
+func SetVerificationFieldsWithSigner(signer neofscrypto.Signer, obj *Object) error {
 	CalculateAndSetPayloadChecksum(obj)
 
-	return SetIDWithSignature(key, obj)
+	return SetIDWithSigner(signer, obj)
 }
 
 // CheckVerificationFields checks all verification fields of the object.
@@ -1,10 +1,16 @@
 package object
 
 import (
+	"bytes"
 	"crypto/rand"
+	"errors"
 	"testing"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -63,3 +69,62 @@ func TestVerificationFields(t *testing.T) {
 		require.NoError(t, CheckVerificationFields(obj))
 	}
 }
+
+func TestPayloadHashWriter(t *testing.T) {
+	payload := make([]byte, 1<<10)
+	_, _ = rand.Read(payload)
+
+	var buf bytes.Buffer
+
+	w := NewPayloadHashWriter(&buf, true)
+
+	for i := 0; i < len(payload); i += 100 {
+		end := i + 100
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		n, err := w.Write(payload[i:end])
+		require.NoError(t, err)
+		require.Equal(t, end-i, n)
+	}
+
+	require.Equal(t, payload, buf.Bytes())
+
+	obj := New()
+	w.WriteChecksums(obj)
+	obj.SetPayload(payload)
+
+	require.EqualValues(t, len(payload), obj.PayloadSize())
+	require.NoError(t, VerifyPayloadChecksum(obj))
+
+	var expected checksum.Checksum
+	checksum.Calculate(&expected, checksum.TZ, payload)
+
+	cs, ok := obj.PayloadHomomorphicHash()
+	require.True(t, ok)
+	require.Equal(t, expected, cs)
+}
+
+func TestSetVerificationFieldsWithSigner(t *testing.T) {
+	p, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	for _, signer := range []neofscrypto.Signer{
+		neofsecdsa.Signer(p.PrivateKey),
+		neofsecdsa.SignerRFC6979(p.PrivateKey),
+		neofsecdsa.SignerWalletConnect(p.PrivateKey),
+	} {
+		obj := New()
+		obj.SetPayload([]byte("Hello, world!"))
+
+		require.NoError(t, SetVerificationFieldsWithSigner(signer, obj))
+		require.NoError(t, CheckVerificationFields(obj))
+		require.EqualValues(t, signer.Scheme(), obj.ToV2().GetSignature().GetScheme())
+	}
+
+	errSign := errors.New("any error")
+
+	err = SetIDWithSigner(neofscryptotest.FailSigner(errSign), New())
+	require.ErrorIs(t, err, errSign)
+}
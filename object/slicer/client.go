@@ -0,0 +1,120 @@
+package slicer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+)
+
+// ClientWriter is an ObjectWriter which stores objects through the NeoFS API
+// client. Must be constructed via NewClientWriter.
+type ClientWriter struct {
+	ctx context.Context
+
+	c *client.Client
+
+	signer neofscrypto.Signer
+
+	session *session.Object
+
+	bearer *bearer.Token
+}
+
+// NewClientWriter constructs ClientWriter which opens new object stream on
+// the given Client for each written object. Context is used for network
+// communication.
+func NewClientWriter(ctx context.Context, c *client.Client) *ClientWriter {
+	return &ClientWriter{
+		ctx: ctx,
+		c:   c,
+	}
+}
+
+// UseKey specifies private key to sign the requests.
+// If key is not provided, then Client default key is used.
+//
+// See also UseSigner.
+func (x *ClientWriter) UseKey(key ecdsa.PrivateKey) {
+	x.UseSigner(neofsecdsa.Signer(key))
+}
+
+// UseSigner specifies signer of the requests. Overrides UseKey.
+// If signer is not provided, then Client default signer is used.
+func (x *ClientWriter) UseSigner(signer neofscrypto.Signer) {
+	x.signer = signer
+}
+
+// WithinSession specifies session within which objects should be stored.
+func (x *ClientWriter) WithinSession(t session.Object) {
+	x.session = &t
+}
+
+// WithBearerToken attaches bearer token to be used for the object writing.
+func (x *ClientWriter) WithBearerToken(t bearer.Token) {
+	x.bearer = &t
+}
+
+// InitDataStream opens object stream using client.Client.ObjectPutInit and
+// writes the header. Implements ObjectWriter.
+func (x *ClientWriter) InitDataStream(header object.Object) (io.WriteCloser, error) {
+	w, err := x.c.ObjectPutInit(x.ctx, client.PrmObjectPutInit{})
+	if err != nil {
+		return nil, err
+	}
+
+	if x.signer != nil {
+		w.UseSigner(x.signer)
+	}
+
+	if x.session != nil {
+		w.WithinSession(*x.session)
+	}
+
+	if x.bearer != nil {
+		w.WithBearerToken(*x.bearer)
+	}
+
+	if !w.WriteHeader(header) {
+		_, err = w.Close()
+		if err == nil {
+			err = errors.New("header writing failed")
+		}
+
+		return nil, err
+	}
+
+	return &payloadWriter{w: w}, nil
+}
+
+// payloadWriter wraps client.ObjectWriter and provides io.WriteCloser.
+type payloadWriter struct {
+	w *client.ObjectWriter
+}
+
+// Write writes the payload chunk. Implements io.Writer.
+func (x *payloadWriter) Write(p []byte) (int, error) {
+	if !x.w.WritePayloadChunk(p) {
+		_, err := x.w.Close()
+		if err == nil {
+			err = io.ErrClosedPipe
+		}
+
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close finishes the object writing. Implements io.Closer.
+func (x *payloadWriter) Close() error {
+	_, err := x.w.Close()
+	return err
+}
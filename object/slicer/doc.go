@@ -0,0 +1,30 @@
+/*
+Package slicer provides raw data slicing into NeoFS objects.
+
+Slicer splits the data stream into physically stored objects which size does
+not exceed the configured limit (usually, the MaxObjectSize network setting).
+Data which fits the limit is stored as a single object. Otherwise, Slicer
+produces a chain of child objects linked through the previous object IDs and
+the common split ID, the last child carries the parent header with checksums
+of the full payload, and the linking object carries the list of all children.
+All headers are signed on the client side.
+
+Define the object writer and slice the data:
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(maxObjectSize)
+
+	s := slicer.New(key, slicer.NewClientWriter(ctx, &c), opts)
+
+	var hdr object.Object
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(&owner)
+	// ...
+
+	id, err := s.Slice(hdr, payload)
+	// ...
+
+The returned identifier refers to the root object: it is the original object
+for the data within the limit and the parent object otherwise.
+
+*/
+package slicer
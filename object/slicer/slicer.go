@@ -0,0 +1,325 @@
+package slicer
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// ObjectWriter represents a virtual object recorder.
+type ObjectWriter interface {
+	// InitDataStream initializes and returns a stream of writable data
+	// associated with the object according to its header. Provided header
+	// is complete: it includes ID, signature and all verification fields.
+	//
+	// Resulting stream must be finally closed. Error returned from Close
+	// means failure of the object saving.
+	InitDataStream(header object.Object) (io.WriteCloser, error)
+}
+
+// Options groups Slicer options.
+type Options struct {
+	objectPayloadLimit uint64
+
+	withHomoChecksum bool
+}
+
+// SetObjectPayloadLimit specifies data size limit for produced physically
+// stored objects. Usually, it is equal to the MaxObjectSize network setting.
+// Required.
+func (x *Options) SetObjectPayloadLimit(l uint64) {
+	x.objectPayloadLimit = l
+}
+
+// CalculateHomomorphicChecksum makes Slicer to calculate and set homomorphic
+// payload checksum in all produced objects. Should be set for containers with
+// enabled homomorphic hashing.
+func (x *Options) CalculateHomomorphicChecksum() {
+	x.withHomoChecksum = true
+}
+
+// Slicer converts input raw data streams into NeoFS objects. Working Slicer
+// must be constructed via New or NewWithSigner.
+type Slicer struct {
+	signer neofscrypto.Signer
+
+	w ObjectWriter
+
+	opts Options
+}
+
+// New constructs Slicer which writes sliced ready-to-go objects owned by
+// particular user into the specified container using provided ObjectWriter.
+// All objects are signed using provided private key.
+//
+// Panics if payload limit is not set in the options.
+//
+// See also NewWithSigner.
+func New(key ecdsa.PrivateKey, w ObjectWriter, opts Options) *Slicer {
+	return NewWithSigner(neofsecdsa.Signer(key), w, opts)
+}
+
+// NewWithSigner is like New but all objects are signed using provided signer
+// of any scheme registered via neofscrypto.RegisterScheme.
+//
+// Panics if payload limit is not set in the options.
+func NewWithSigner(signer neofscrypto.Signer, w ObjectWriter, opts Options) *Slicer {
+	if opts.objectPayloadLimit == 0 {
+		panic("zero object payload limit")
+	}
+
+	return &Slicer{
+		signer: signer,
+		w:      w,
+		opts:   opts,
+	}
+}
+
+// Slice creates new NeoFS object from the input data stream, associates the
+// object with the configured container and writes the object via underlying
+// ObjectWriter. The object is created according to the header template: the
+// container, owner, version, creation epoch, session token, type and attributes
+// are inherited, all other fields are calculated by Slicer. Returns ID of the
+// root object.
+//
+// If the data exceeds the configured limit, Slice writes a chain of child
+// objects, their parent and the linking object (see package docs).
+//
+// Slice reads the data till io.EOF, any other read error is returned.
+func (x *Slicer) Slice(hdr object.Object, data io.Reader) (oid.ID, error) {
+	buf := make([]byte, x.opts.objectPayloadLimit)
+	r := partReader{r: data}
+
+	n, last, err := r.read(buf)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("read payload: %w", err)
+	}
+
+	if last {
+		obj := x.initHeader(hdr)
+		obj.SetType(hdr.Type())
+		obj.SetAttributes(hdr.Attributes()...)
+		x.setPayloadChecksums(obj, buf[:n])
+
+		return x.writeObject(obj, buf[:n])
+	}
+
+	var (
+		splitID    = object.NewSplitID()
+		children   []oid.ID
+		homoHashes []checksum.Checksum
+		parent     *object.Object
+		total      uint64
+
+		hashSHA = checksum.NewHasher(checksum.SHA256)
+	)
+
+	for {
+		part := buf[:n]
+		total += uint64(n)
+
+		hashSHA.Write(part)
+
+		child := x.initHeader(hdr)
+		child.SetSplitID(splitID)
+
+		if len(children) > 0 {
+			child.SetPreviousID(children[len(children)-1])
+		}
+
+		x.setPayloadChecksums(child, part)
+
+		if x.opts.withHomoChecksum {
+			cs, _ := child.PayloadHomomorphicHash()
+			homoHashes = append(homoHashes, cs)
+		}
+
+		if last {
+			parent, err = x.parentHeader(hdr, total, hashSHA.Checksum(), homoHashes)
+			if err != nil {
+				return oid.ID{}, err
+			}
+
+			child.SetParent(parent)
+		}
+
+		id, err := x.writeObject(child, part)
+		if err != nil {
+			return oid.ID{}, fmt.Errorf("write child object #%d: %w", len(children), err)
+		}
+
+		children = append(children, id)
+
+		if last {
+			break
+		}
+
+		n, last, err = r.read(buf)
+		if err != nil {
+			return oid.ID{}, fmt.Errorf("read payload: %w", err)
+		}
+	}
+
+	link := x.initHeader(hdr)
+	link.SetSplitID(splitID)
+	link.SetParent(parent)
+	link.SetChildren(children...)
+	x.setPayloadChecksums(link, nil)
+
+	_, err = x.writeObject(link, nil)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("write linking object: %w", err)
+	}
+
+	id, _ := parent.ID()
+
+	return id, nil
+}
+
+// initHeader returns new object header with the fields inherited by all objects
+// produced from the hdr template.
+func (x *Slicer) initHeader(hdr object.Object) *object.Object {
+	obj := object.New()
+
+	if cnr, ok := hdr.ContainerID(); ok {
+		obj.SetContainerID(cnr)
+	}
+
+	obj.SetOwnerID(hdr.OwnerID())
+	obj.SetCreationEpoch(hdr.CreationEpoch())
+	obj.SetSessionToken(hdr.SessionToken())
+
+	if hdr.ToV2().GetHeader().GetVersion() != nil {
+		obj.SetVersion(hdr.Version())
+	} else {
+		ver := version.Current()
+		obj.SetVersion(&ver)
+	}
+
+	return obj
+}
+
+// parentHeader forms signed header of the parent object of the given payload
+// size and checksum. Homomorphic hash of the parent payload is concatenated
+// from the children's ones, if any.
+func (x *Slicer) parentHeader(hdr object.Object, size uint64, cs checksum.Checksum, homoHashes []checksum.Checksum) (*object.Object, error) {
+	parent := x.initHeader(hdr)
+	parent.SetType(hdr.Type())
+	parent.SetAttributes(hdr.Attributes()...)
+	parent.SetPayloadSize(size)
+	parent.SetPayloadChecksum(cs)
+
+	if len(homoHashes) > 0 {
+		var csHomo checksum.Checksum
+
+		if err := checksum.ConcatTZ(&csHomo, homoHashes...); err != nil {
+			return nil, fmt.Errorf("calculate parent homomorphic hash: %w", err)
+		}
+
+		parent.SetPayloadHomomorphicHash(csHomo)
+	}
+
+	if err := object.SetIDWithSigner(x.signer, parent); err != nil {
+		return nil, fmt.Errorf("finalize parent header: %w", err)
+	}
+
+	return parent, nil
+}
+
+// setPayloadChecksums writes size and checksums of the given payload to
+// the object header.
+func (x *Slicer) setPayloadChecksums(obj *object.Object, payload []byte) {
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.SHA256, payload)
+	obj.SetPayloadChecksum(cs)
+
+	if x.opts.withHomoChecksum {
+		var csHomo checksum.Checksum
+		checksum.Calculate(&csHomo, checksum.TZ, payload)
+		obj.SetPayloadHomomorphicHash(csHomo)
+	}
+}
+
+// writeObject signs the object header and writes the object through the
+// underlying ObjectWriter. Payload checksums must be already set.
+// Returns ID of the written object.
+func (x *Slicer) writeObject(obj *object.Object, payload []byte) (oid.ID, error) {
+	if err := object.SetIDWithSigner(x.signer, obj); err != nil {
+		return oid.ID{}, fmt.Errorf("finalize object header: %w", err)
+	}
+
+	stream, err := x.w.InitDataStream(*obj)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("init data stream: %w", err)
+	}
+
+	if len(payload) > 0 {
+		if _, err = stream.Write(payload); err != nil {
+			_ = stream.Close()
+			return oid.ID{}, fmt.Errorf("write payload: %w", err)
+		}
+	}
+
+	if err = stream.Close(); err != nil {
+		return oid.ID{}, fmt.Errorf("close data stream: %w", err)
+	}
+
+	id, _ := obj.ID()
+
+	return id, nil
+}
+
+// partReader reads the data stream by parts of the fixed size and detects
+// the last one.
+type partReader struct {
+	r io.Reader
+
+	nextSet bool
+	next    [1]byte
+}
+
+// read reads next part of the data into buf and returns number of bytes read.
+// The flag is set if there is no more data left in the stream.
+func (x *partReader) read(buf []byte) (int, bool, error) {
+	var n int
+
+	if x.nextSet {
+		buf[0] = x.next[0]
+		x.nextSet = false
+		n = 1
+	}
+
+	m, err := io.ReadFull(x.r, buf[n:])
+	n += m
+
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return n, true, nil
+		}
+
+		return n, false, err
+	}
+
+	_, err = io.ReadFull(x.r, x.next[:])
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return n, true, nil
+		}
+
+		return n, false, err
+	}
+
+	x.nextSet = true
+
+	return n, false, nil
+}
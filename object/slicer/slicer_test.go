@@ -0,0 +1,187 @@
+package slicer_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStream struct {
+	bytes.Buffer
+
+	w   *memoryWriter
+	hdr object.Object
+}
+
+func (x *memoryStream) Close() error {
+	x.hdr.SetPayload(x.Bytes())
+	x.w.objects = append(x.w.objects, x.hdr)
+	return nil
+}
+
+type memoryWriter struct {
+	objects []object.Object
+}
+
+func (x *memoryWriter) InitDataStream(hdr object.Object) (io.WriteCloser, error) {
+	return &memoryStream{w: x, hdr: hdr}, nil
+}
+
+func randomPayload(t *testing.T, size int) []byte {
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func newHeader(t *testing.T) object.Object {
+	var hdr object.Object
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(usertest.ID())
+	hdr.SetCreationEpoch(13)
+
+	var a object.Attribute
+	a.SetKey("key")
+	a.SetValue("value")
+	hdr.SetAttributes(a)
+
+	return hdr
+}
+
+func TestSlicer_Slice(t *testing.T) {
+	const limit = 1 << 10
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(limit)
+	opts.CalculateHomomorphicChecksum()
+
+	t.Run("small object", func(t *testing.T) {
+		var w memoryWriter
+		s := slicer.New(k.PrivateKey, &w, opts)
+
+		hdr := newHeader(t)
+		payload := randomPayload(t, limit)
+
+		id, err := s.Slice(hdr, bytes.NewReader(payload))
+		require.NoError(t, err)
+		require.Len(t, w.objects, 1)
+
+		obj := w.objects[0]
+		require.NoError(t, object.CheckVerificationFields(&obj))
+		require.Equal(t, payload, obj.Payload())
+		require.Equal(t, hdr.Attributes(), obj.Attributes())
+		require.Nil(t, obj.SplitID())
+
+		objID, _ := obj.ID()
+		require.Equal(t, id, objID)
+	})
+
+	t.Run("empty payload", func(t *testing.T) {
+		var w memoryWriter
+		s := slicer.New(k.PrivateKey, &w, opts)
+
+		_, err := s.Slice(newHeader(t), bytes.NewReader(nil))
+		require.NoError(t, err)
+		require.Len(t, w.objects, 1)
+		require.Zero(t, w.objects[0].PayloadSize())
+	})
+
+	t.Run("signer", func(t *testing.T) {
+		var w memoryWriter
+		signer := neofsecdsa.SignerRFC6979(k.PrivateKey)
+		s := slicer.NewWithSigner(signer, &w, opts)
+
+		_, err := s.Slice(newHeader(t), bytes.NewReader(randomPayload(t, 2*limit+limit/2)))
+		require.NoError(t, err)
+		require.Len(t, w.objects, 4) // 3 children and linking object
+
+		for i := range w.objects {
+			require.NoError(t, object.CheckHeaderVerificationFields(&w.objects[i]))
+			require.EqualValues(t, signer.Scheme(), w.objects[i].ToV2().GetSignature().GetScheme())
+		}
+	})
+
+	t.Run("large object", func(t *testing.T) {
+		var w memoryWriter
+		s := slicer.New(k.PrivateKey, &w, opts)
+
+		hdr := newHeader(t)
+		payload := randomPayload(t, 3*limit+limit/2)
+
+		id, err := s.Slice(hdr, bytes.NewReader(payload))
+		require.NoError(t, err)
+
+		// 4 children and linking object
+		require.Len(t, w.objects, 5)
+
+		children := w.objects[:4]
+		link := w.objects[4]
+		splitID := children[0].SplitID()
+		require.NotNil(t, splitID)
+
+		var (
+			ids   []oid.ID
+			glued []byte
+		)
+
+		for i := range children {
+			require.NoError(t, object.CheckVerificationFields(&children[i]))
+			require.LessOrEqual(t, children[i].PayloadSize(), uint64(limit))
+			require.Equal(t, splitID.ToV2(), children[i].SplitID().ToV2())
+			require.Empty(t, children[i].Attributes())
+
+			prev, ok := children[i].PreviousID()
+			if i == 0 {
+				require.False(t, ok)
+			} else {
+				require.True(t, ok)
+				require.Equal(t, ids[i-1], prev)
+			}
+
+			childID, _ := children[i].ID()
+			ids = append(ids, childID)
+			glued = append(glued, children[i].Payload()...)
+		}
+
+		require.Equal(t, payload, glued)
+
+		parent := children[len(children)-1].Parent()
+		require.NotNil(t, parent)
+		require.NoError(t, object.CheckHeaderVerificationFields(parent))
+		require.EqualValues(t, len(payload), parent.PayloadSize())
+		require.Equal(t, hdr.Attributes(), parent.Attributes())
+
+		cs, ok := parent.PayloadChecksum()
+		require.True(t, ok)
+		sum := sha256.Sum256(payload)
+		require.Equal(t, sum[:], cs.Value())
+
+		_, ok = parent.PayloadHomomorphicHash()
+		require.True(t, ok)
+
+		parentID, _ := parent.ID()
+		require.Equal(t, id, parentID)
+
+		require.NoError(t, object.CheckVerificationFields(&link))
+		require.Equal(t, ids, link.Children())
+		require.Equal(t, splitID.ToV2(), link.SplitID().ToV2())
+
+		linkParentID, ok := link.ParentID()
+		require.True(t, ok)
+		require.Equal(t, id, linkParentID)
+	})
+}
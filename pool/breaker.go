@@ -0,0 +1,163 @@
+package pool
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// circuitBreaker counts failures of the requests to the node and decides
+// when the node should be quarantined. Quarantined node is excluded from the
+// rotation until the quarantine expires. After that, the next health check
+// is a probe (half-open state): if it succeeds, the node returns to the
+// rotation, otherwise the quarantine is prolonged.
+type circuitBreaker struct {
+	mtx sync.Mutex
+
+	consecutive uint32
+
+	// results of the last requests, true means failure
+	window   []bool
+	next     int
+	filled   bool
+	failures uint32
+
+	// zero if node is not quarantined
+	quarantineTill time.Time
+}
+
+// breakerParameters groups thresholds of the node quarantine.
+type breakerParameters struct {
+	errorThreshold     uint32
+	errorRateThreshold float64
+	errorRateWindow    uint32
+	quarantineDuration time.Duration
+}
+
+func (x breakerParameters) enabled() bool {
+	return x.errorThreshold > 0 || x.errorRateThreshold > 0
+}
+
+// report registers result of the request to the node. Returns true if the node
+// has been quarantined.
+func (x *circuitBreaker) report(failed bool, prm breakerParameters, now time.Time) bool {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if !x.quarantineTill.IsZero() {
+		// already quarantined, health check decides further
+		return false
+	}
+
+	if failed {
+		x.consecutive++
+	} else {
+		x.consecutive = 0
+	}
+
+	if prm.errorRateThreshold > 0 && prm.errorRateWindow > 0 {
+		if x.window == nil {
+			x.window = make([]bool, prm.errorRateWindow)
+		}
+
+		if x.filled && x.window[x.next] {
+			x.failures--
+		}
+
+		x.window[x.next] = failed
+		if failed {
+			x.failures++
+		}
+
+		x.next++
+		if x.next == len(x.window) {
+			x.next = 0
+			x.filled = true
+		}
+	}
+
+	trip := prm.errorThreshold > 0 && x.consecutive >= prm.errorThreshold ||
+		x.filled && float64(x.failures)/float64(len(x.window)) > prm.errorRateThreshold
+
+	if trip {
+		x.quarantine(prm, now)
+	}
+
+	return trip
+}
+
+// state returns true if the node is quarantined. The second value is true if
+// the quarantine has expired and the node should be probed.
+func (x *circuitBreaker) state(now time.Time) (quarantined bool, probe bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.quarantineTill.IsZero() {
+		return false, false
+	}
+
+	if now.Before(x.quarantineTill) {
+		return true, false
+	}
+
+	return false, true
+}
+
+// probed registers result of the probe of the quarantined node.
+func (x *circuitBreaker) probed(ok bool, prm breakerParameters, now time.Time) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if ok {
+		x.quarantineTill = time.Time{}
+		return
+	}
+
+	x.quarantine(prm, now)
+}
+
+// quarantine starts the quarantine and resets the counters.
+// Must be called under the lock.
+func (x *circuitBreaker) quarantine(prm breakerParameters, now time.Time) {
+	x.quarantineTill = now.Add(prm.quarantineDuration)
+	x.consecutive = 0
+	x.failures = 0
+	x.next = 0
+	x.filled = false
+
+	for i := range x.window {
+		x.window[i] = false
+	}
+}
+
+// quarantine marks the node as unhealthy and excludes it from the sampler.
+// Returns false if the node is already unhealthy.
+func (p *innerPool) quarantine(cp *clientPack) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !cp.healthy {
+		return false
+	}
+
+	cp.healthy = false
+
+	if len(p.weights) != len(p.clientPacks) {
+		return true
+	}
+
+	weights := make([]float64, len(p.weights))
+	copy(weights, p.weights)
+
+	for j := range p.clientPacks {
+		if p.clientPacks[j] == cp {
+			weights[j] = 0
+		}
+	}
+
+	probabilities := adjustWeights(weights)
+	p.sampler = newSampler(probabilities, rand.NewSource(time.Now().UnixNano()))
+	p.weights = probabilities
+
+	return true
+}
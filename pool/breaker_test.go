@@ -0,0 +1,154 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+
+	t.Run("consecutive errors", func(t *testing.T) {
+		var b circuitBreaker
+		prm := breakerParameters{errorThreshold: 3, quarantineDuration: time.Minute}
+
+		require.False(t, b.report(true, prm, now))
+		require.False(t, b.report(true, prm, now))
+		require.False(t, b.report(false, prm, now))
+		require.False(t, b.report(true, prm, now))
+		require.False(t, b.report(true, prm, now))
+		require.True(t, b.report(true, prm, now))
+
+		quarantined, probe := b.state(now.Add(time.Second))
+		require.True(t, quarantined)
+		require.False(t, probe)
+
+		quarantined, probe = b.state(now.Add(time.Minute))
+		require.False(t, quarantined)
+		require.True(t, probe)
+
+		// failed probe prolongs the quarantine
+		b.probed(false, prm, now.Add(time.Minute))
+		quarantined, _ = b.state(now.Add(time.Minute + time.Second))
+		require.True(t, quarantined)
+
+		b.probed(true, prm, now.Add(2*time.Minute))
+		quarantined, probe = b.state(now.Add(2 * time.Minute))
+		require.False(t, quarantined)
+		require.False(t, probe)
+	})
+
+	t.Run("error rate", func(t *testing.T) {
+		var b circuitBreaker
+		prm := breakerParameters{errorRateThreshold: 0.5, errorRateWindow: 4, quarantineDuration: time.Minute}
+
+		// window is not filled yet
+		require.False(t, b.report(true, prm, now))
+		require.False(t, b.report(false, prm, now))
+		require.False(t, b.report(false, prm, now))
+		// 2 of 4 failed
+		require.False(t, b.report(true, prm, now))
+		// the first failure is out of the window
+		require.False(t, b.report(false, prm, now))
+		require.False(t, b.report(true, prm, now))
+		// 3 of 4 failed
+		require.True(t, b.report(true, prm, now))
+	})
+}
+
+func TestPoolQuarantine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	errTransport := errors.New("connection refused")
+
+	failed := NewMockClient(ctrl)
+	failed.EXPECT().containerGet(gomock.Any(), gomock.Any()).Return(nil, errTransport).Times(2)
+	failed.EXPECT().statistic().Return(nil).AnyTimes()
+
+	cnr := container.New()
+
+	healthy := NewMockClient(ctrl)
+	healthy.EXPECT().containerGet(gomock.Any(), gomock.Any()).Return(cnr, nil).AnyTimes()
+	healthy.EXPECT().endpointInfo(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	healthy.EXPECT().statistic().Return(nil).AnyTimes()
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	weights := []float64{1, 0}
+
+	inner := &innerPool{
+		// sampler always selects the failed node while it's healthy
+		sampler: newSampler(weights, rand.NewSource(0)),
+		clientPacks: []*clientPack{
+			{client: failed, healthy: true, address: "failed", breaker: new(circuitBreaker)},
+			{client: healthy, healthy: true, address: "healthy", breaker: new(circuitBreaker)},
+		},
+		weights: []float64{0.5, 0.5},
+	}
+
+	p := &Pool{
+		innerPools: []*innerPool{inner},
+		cache:      cache,
+		rebalanceParams: rebalanceParameters{
+			nodesParams:        []*nodesParam{{weights: []float64{1, 1}}},
+			nodeRequestTimeout: time.Second,
+			breaker: breakerParameters{
+				errorThreshold:     2,
+				quarantineDuration: time.Hour,
+			},
+		},
+	}
+
+	var prm PrmContainerGet
+	prm.SetContainerID(cidtest.ID())
+
+	for i := 0; i < 2; i++ {
+		_, err = p.GetContainer(context.Background(), prm)
+		require.ErrorIs(t, err, errTransport)
+	}
+
+	// the failed node is excluded from the rotation without health check
+	for i := 0; i < 10; i++ {
+		res, err := p.GetContainer(context.Background(), prm)
+		require.NoError(t, err)
+		require.Equal(t, cnr, res)
+	}
+
+	stat, err := p.Statistic().Node("failed")
+	require.NoError(t, err)
+	require.False(t, stat.Healthy())
+	require.Zero(t, stat.Weight())
+
+	// health check doesn't return the node until the quarantine expires
+	p.updateInnerNodesHealth(context.Background(), 0, make([]float64, 2))
+
+	inner.lock.RLock()
+	require.False(t, inner.clientPacks[0].healthy)
+	inner.lock.RUnlock()
+
+	// successful probe returns the node
+	inner.clientPacks[0].breaker.mtx.Lock()
+	inner.clientPacks[0].breaker.quarantineTill = time.Now()
+	inner.clientPacks[0].breaker.mtx.Unlock()
+
+	failed.EXPECT().endpointInfo(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	p.updateInnerNodesHealth(context.Background(), 0, make([]float64, 2))
+
+	inner.lock.RLock()
+	require.True(t, inner.clientPacks[0].healthy)
+	inner.lock.RUnlock()
+
+	quarantined, probe := inner.clientPacks[0].breaker.state(time.Now())
+	require.False(t, quarantined)
+	require.False(t, probe)
+}
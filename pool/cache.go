@@ -2,6 +2,7 @@ package pool
 
 import (
 	"strings"
+	"sync"
 	"sync/atomic"
 
 	lru "github.com/hashicorp/golang-lru"
@@ -15,6 +16,11 @@ type sessionCache struct {
 
 type cacheValue struct {
 	token session.Object
+
+	// results of the shared session usage on the nodes: true if the node knows
+	// the session, false otherwise
+	mtx   sync.Mutex
+	nodes map[string]bool
 }
 
 func newCache() (*sessionCache, error) {
@@ -50,6 +56,46 @@ func (c *sessionCache) Put(key string, token session.Object) bool {
 	})
 }
 
+// SetNodeKnows records whether the node with the given address knows the
+// session cached by the key. Does nothing if the session is missing.
+func (c *sessionCache) SetNodeKnows(key, address string, known bool) {
+	valueRaw, ok := c.cache.Peek(key)
+	if !ok {
+		return
+	}
+
+	value := valueRaw.(*cacheValue)
+
+	value.mtx.Lock()
+	if value.nodes == nil {
+		value.nodes = make(map[string]bool)
+	}
+	value.nodes[address] = known
+	value.mtx.Unlock()
+}
+
+// NodeKnows checks whether the node with the given address knows the session
+// cached by the key. The second value is false if the node has not been
+// recorded yet or the session is missing.
+func (c *sessionCache) NodeKnows(key, address string) (known bool, recorded bool) {
+	valueRaw, ok := c.cache.Peek(key)
+	if !ok {
+		return false, false
+	}
+
+	value := valueRaw.(*cacheValue)
+
+	value.mtx.Lock()
+	known, recorded = value.nodes[address]
+	value.mtx.Unlock()
+
+	return known, recorded
+}
+
+func (c *sessionCache) Delete(key string) {
+	c.cache.Remove(key)
+}
+
 func (c *sessionCache) DeleteByPrefix(prefix string) {
 	for _, key := range c.cache.Keys() {
 		if strings.HasPrefix(key.(string), prefix) {
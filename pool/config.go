@@ -0,0 +1,323 @@
+package pool
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+)
+
+// Duration is a time.Duration encoded as a string like "1m30s" in the
+// configuration files.
+type Duration time.Duration
+
+// MarshalText implements encoding.TextMarshaler.
+func (x Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(x).String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (x *Duration) UnmarshalText(text []byte) error {
+	d, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*x = Duration(d)
+
+	return nil
+}
+
+// NodeConfig describes the node in Config.
+type NodeConfig struct {
+	// Address of the node in [scheme://]host:port format. Supported schemes
+	// are grpc and grpcs.
+	Address string `yaml:"address" json:"address"`
+
+	Priority int     `yaml:"priority" json:"priority"`
+	Weight   float64 `yaml:"weight" json:"weight"`
+}
+
+// WalletConfig describes the account of the NEP-6 wallet in Config.
+type WalletConfig struct {
+	// Path to the wallet file.
+	Path string `yaml:"path" json:"path"`
+
+	// Address of the account. If empty, the default account is used, or the
+	// first one if there is no default account.
+	Address string `yaml:"address" json:"address"`
+
+	// Password to decrypt the account key.
+	Password string `yaml:"password" json:"password"`
+}
+
+// Config is a declarative Pool configuration. It can be decoded from JSON
+// using encoding/json or from YAML using any library which supports yaml tags
+// and encoding.TextUnmarshaler (e.g. gopkg.in/yaml.v3), and overlaid by the
+// environment variables using ReadEnv.
+//
+// Zero durations and session expiration mean defaults of InitParameters.
+//
+// See also InitParameters.
+type Config struct {
+	// Private key in hex or WIF format. Mutually exclusive with Wallet.
+	Key string `yaml:"key" json:"key"`
+
+	// NEP-6 wallet with the private key. Mutually exclusive with Key.
+	Wallet WalletConfig `yaml:"wallet" json:"wallet"`
+
+	Nodes []NodeConfig `yaml:"nodes" json:"nodes"`
+
+	NodeDialTimeout           Duration `yaml:"node_dial_timeout" json:"node_dial_timeout"`
+	HealthcheckTimeout        Duration `yaml:"healthcheck_timeout" json:"healthcheck_timeout"`
+	ClientRebalanceInterval   Duration `yaml:"rebalance_interval" json:"rebalance_interval"`
+	SessionExpirationDuration uint64   `yaml:"session_expiration_duration" json:"session_expiration_duration"`
+}
+
+// ReadEnv overrides configuration values by the environment variables with the
+// given prefix. Variable names are upper-case names of the configuration
+// fields joined with underscore, e.g. for the "NEOFS" prefix:
+//
+//	NEOFS_KEY
+//	NEOFS_WALLET_PATH
+//	NEOFS_WALLET_ADDRESS
+//	NEOFS_WALLET_PASSWORD
+//	NEOFS_NODE_DIAL_TIMEOUT
+//	NEOFS_HEALTHCHECK_TIMEOUT
+//	NEOFS_REBALANCE_INTERVAL
+//	NEOFS_SESSION_EXPIRATION_DURATION
+//
+// Nodes are set by index starting from 0: NEOFS_NODES_0_ADDRESS,
+// NEOFS_NODES_0_PRIORITY, NEOFS_NODES_0_WEIGHT etc. Nodes are overridden
+// one by one, new nodes are appended. Returns an error if some variable has
+// invalid format.
+func (x *Config) ReadEnv(prefix string) error {
+	return x.readEnv(prefix, os.LookupEnv)
+}
+
+func (x *Config) readEnv(prefix string, lookup func(string) (string, bool)) error {
+	var err error
+
+	str := func(name string, dst *string) {
+		if v, ok := lookup(prefix + "_" + name); ok {
+			*dst = v
+		}
+	}
+
+	parse := func(name string, f func(string) error) {
+		if v, ok := lookup(prefix + "_" + name); ok && err == nil {
+			if errParse := f(v); errParse != nil {
+				err = fmt.Errorf("invalid %s_%s: %w", prefix, name, errParse)
+			}
+		}
+	}
+
+	duration := func(name string, dst *Duration) {
+		parse(name, func(v string) error {
+			return dst.UnmarshalText([]byte(v))
+		})
+	}
+
+	str("KEY", &x.Key)
+	str("WALLET_PATH", &x.Wallet.Path)
+	str("WALLET_ADDRESS", &x.Wallet.Address)
+	str("WALLET_PASSWORD", &x.Wallet.Password)
+	duration("NODE_DIAL_TIMEOUT", &x.NodeDialTimeout)
+	duration("HEALTHCHECK_TIMEOUT", &x.HealthcheckTimeout)
+	duration("REBALANCE_INTERVAL", &x.ClientRebalanceInterval)
+	parse("SESSION_EXPIRATION_DURATION", func(v string) (err error) {
+		x.SessionExpirationDuration, err = strconv.ParseUint(v, 10, 64)
+		return
+	})
+
+	for i := 0; err == nil; i++ {
+		name := "NODES_" + strconv.Itoa(i) + "_"
+
+		address, okAddress := lookup(prefix + "_" + name + "ADDRESS")
+		_, okPriority := lookup(prefix + "_" + name + "PRIORITY")
+		_, okWeight := lookup(prefix + "_" + name + "WEIGHT")
+
+		if !okAddress && !okPriority && !okWeight {
+			break
+		}
+
+		if i == len(x.Nodes) {
+			x.Nodes = append(x.Nodes, NodeConfig{})
+		}
+
+		if okAddress {
+			x.Nodes[i].Address = address
+		}
+
+		parse(name+"PRIORITY", func(v string) (err error) {
+			x.Nodes[i].Priority, err = strconv.Atoi(v)
+			return
+		})
+		parse(name+"WEIGHT", func(v string) (err error) {
+			x.Nodes[i].Weight, err = strconv.ParseFloat(v, 64)
+			return
+		})
+	}
+
+	return err
+}
+
+// PrivateKey loads the configured private key.
+func (x Config) PrivateKey() (*ecdsa.PrivateKey, error) {
+	switch {
+	case x.Key != "" && x.Wallet.Path != "":
+		return nil, errors.New("both key and wallet are configured")
+	case x.Key != "":
+		k, err := keys.NewPrivateKeyFromHex(x.Key)
+		if err != nil {
+			var errWIF error
+
+			k, errWIF = keys.NewPrivateKeyFromWIF(x.Key)
+			if errWIF != nil {
+				return nil, errors.New("key is neither hex nor WIF")
+			}
+		}
+
+		return &k.PrivateKey, nil
+	case x.Wallet.Path != "":
+		return x.Wallet.privateKey()
+	default:
+		return nil, errors.New("neither key nor wallet is configured")
+	}
+}
+
+// privateKey decrypts the key of the configured account.
+func (x WalletConfig) privateKey() (*ecdsa.PrivateKey, error) {
+	w, err := wallet.NewWalletFromFile(x.Path)
+	if err != nil {
+		return nil, fmt.Errorf("open wallet: %w", err)
+	}
+
+	var acc *wallet.Account
+
+	for _, a := range w.Accounts {
+		if x.Address != "" && a.Address == x.Address || x.Address == "" && a.Default {
+			acc = a
+			break
+		}
+	}
+
+	if acc == nil {
+		if x.Address != "" {
+			return nil, fmt.Errorf("account %s not found in the wallet", x.Address)
+		}
+
+		if len(w.Accounts) == 0 {
+			return nil, errors.New("wallet has no accounts")
+		}
+
+		acc = w.Accounts[0]
+	}
+
+	if err = acc.Decrypt(x.Password, w.Scrypt); err != nil {
+		return nil, fmt.Errorf("decrypt account %s: %w", acc.Address, err)
+	}
+
+	return &acc.PrivateKey().PrivateKey, nil
+}
+
+// checkEndpoint checks that the node address has [scheme://]host:port format
+// accepted by the client.
+func checkEndpoint(address string) error {
+	hostPort := address
+
+	if i := strings.Index(address, "://"); i >= 0 {
+		switch scheme := address[:i]; scheme {
+		case "grpc", "grpcs":
+		default:
+			return fmt.Errorf("unsupported scheme %s", scheme)
+		}
+
+		hostPort = address[i+3:]
+	}
+
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return err
+	}
+
+	if host == "" {
+		return errors.New("missing host")
+	}
+
+	if n, err := strconv.ParseUint(port, 10, 16); err != nil || n == 0 {
+		return fmt.Errorf("invalid port %s", port)
+	}
+
+	return nil
+}
+
+// InitParameters validates the configuration and converts it to InitParameters.
+// The private key is loaded using PrivateKey. All errors are returned at once.
+func (x Config) InitParameters() (InitParameters, error) {
+	var (
+		prm  InitParameters
+		errs []string
+	)
+
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+
+	key, err := x.PrivateKey()
+	if err != nil {
+		fail("load key: %v", err)
+	}
+
+	if len(x.Nodes) == 0 {
+		fail("no nodes configured")
+	}
+
+	for i, node := range x.Nodes {
+		if node.Address == "" {
+			fail("node #%d: empty address", i)
+		} else if err := checkEndpoint(node.Address); err != nil {
+			fail("node #%d: invalid address %s: %v", i, node.Address, err)
+		}
+
+		if node.Weight <= 0 {
+			fail("node #%d: non-positive weight %v", i, node.Weight)
+		}
+
+		prm.AddNode(NewNodeParam(node.Priority, node.Address, node.Weight))
+	}
+
+	durations := []struct {
+		name string
+		v    Duration
+	}{
+		{"node dial timeout", x.NodeDialTimeout},
+		{"healthcheck timeout", x.HealthcheckTimeout},
+		{"rebalance interval", x.ClientRebalanceInterval},
+	}
+
+	for _, d := range durations {
+		if d.v < 0 {
+			fail("negative %s %v", d.name, time.Duration(d.v))
+		}
+	}
+
+	if len(errs) > 0 {
+		return InitParameters{}, fmt.Errorf("invalid pool configuration: %s", strings.Join(errs, "; "))
+	}
+
+	prm.SetKey(key)
+	prm.SetNodeDialTimeout(time.Duration(x.NodeDialTimeout))
+	prm.SetHealthcheckTimeout(time.Duration(x.HealthcheckTimeout))
+	prm.SetClientRebalanceInterval(time.Duration(x.ClientRebalanceInterval))
+	prm.SetSessionExpirationDuration(x.SessionExpirationDuration)
+
+	return prm, nil
+}
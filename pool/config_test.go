@@ -0,0 +1,153 @@
+package pool
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_ReadEnv(t *testing.T) {
+	env := map[string]string{
+		"NEOFS_KEY":                         "key",
+		"NEOFS_NODE_DIAL_TIMEOUT":           "3s",
+		"NEOFS_SESSION_EXPIRATION_DURATION": "50",
+		"NEOFS_NODES_0_WEIGHT":              "2",
+		"NEOFS_NODES_1_ADDRESS":             "grpcs://node1:8080",
+		"NEOFS_NODES_1_PRIORITY":            "2",
+		"NEOFS_NODES_1_WEIGHT":              "0.5",
+		"NEOFS_NODES_3_ADDRESS":             "grpc://skipped:8080",
+	}
+
+	lookup := func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+
+	var cfg Config
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"key": "overridden",
+		"nodes": [{"address": "node0:8080", "priority": 1, "weight": 1}],
+		"healthcheck_timeout": "1m30s"
+	}`), &cfg))
+
+	require.NoError(t, cfg.readEnv("NEOFS", lookup))
+	require.Equal(t, Config{
+		Key: "key",
+		Nodes: []NodeConfig{
+			{Address: "node0:8080", Priority: 1, Weight: 2},
+			{Address: "grpcs://node1:8080", Priority: 2, Weight: 0.5},
+		},
+		NodeDialTimeout:           Duration(3 * time.Second),
+		HealthcheckTimeout:        Duration(90 * time.Second),
+		SessionExpirationDuration: 50,
+	}, cfg)
+
+	env["NEOFS_NODES_0_PRIORITY"] = "high"
+	require.Error(t, cfg.readEnv("NEOFS", lookup))
+}
+
+func TestConfig_PrivateKey(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var cfg Config
+
+	_, err = cfg.PrivateKey()
+	require.Error(t, err)
+
+	for _, s := range []string{hex.EncodeToString(k.Bytes()), k.WIF()} {
+		cfg.Key = s
+
+		key, err := cfg.PrivateKey()
+		require.NoError(t, err)
+		require.Equal(t, k.PrivateKey, *key)
+	}
+
+	cfg.Key = "not a key"
+	_, err = cfg.PrivateKey()
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "wallet.json")
+
+	w, err := wallet.NewWallet(path)
+	require.NoError(t, err)
+
+	acc := wallet.NewAccountFromPrivateKey(k)
+	require.NoError(t, acc.Encrypt("pass", w.Scrypt))
+
+	w.AddAccount(acc)
+	require.NoError(t, w.Save())
+
+	cfg.Key = ""
+	cfg.Wallet = WalletConfig{Path: path, Password: "pass"}
+
+	key, err := cfg.PrivateKey()
+	require.NoError(t, err)
+	require.Equal(t, k.PrivateKey, *key)
+
+	cfg.Wallet.Address = "NNLi44dJNXtDNSBkofB48aTVYtb1zZrNEs"
+	_, err = cfg.PrivateKey()
+	require.Error(t, err)
+
+	cfg.Wallet.Address = acc.Address
+	cfg.Wallet.Password = "wrong"
+	_, err = cfg.PrivateKey()
+	require.Error(t, err)
+
+	cfg.Key = hex.EncodeToString(k.Bytes())
+	_, err = cfg.PrivateKey()
+	require.Error(t, err)
+}
+
+func TestConfig_InitParameters(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	cfg := Config{
+		Key: k.WIF(),
+		Nodes: []NodeConfig{
+			{Address: "grpc://node0:8080", Priority: 1, Weight: 1},
+			{Address: "node1:8080", Priority: 2, Weight: 3},
+		},
+		ClientRebalanceInterval: Duration(time.Minute),
+	}
+
+	prm, err := cfg.InitParameters()
+	require.NoError(t, err)
+	require.Equal(t, neofsecdsa.Signer(k.PrivateKey), prm.signer)
+	require.Equal(t, []NodeParam{
+		NewNodeParam(1, "grpc://node0:8080", 1),
+		NewNodeParam(2, "node1:8080", 3),
+	}, prm.nodeParams)
+	require.Equal(t, time.Minute, prm.clientRebalanceInterval)
+
+	for _, address := range []string{"", "node", "http://node:8080", "node:port", ":8080"} {
+		cfg.Nodes[0].Address = address
+
+		_, err = cfg.InitParameters()
+		require.Error(t, err, address)
+	}
+
+	cfg.Nodes[0].Address = "node0:8080"
+	cfg.Nodes[1].Weight = 0
+	cfg.HealthcheckTimeout = Duration(-time.Second)
+	cfg.Key = ""
+
+	_, err = cfg.InitParameters()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "load key")
+	require.Contains(t, err.Error(), "weight")
+	require.Contains(t, err.Error(), "healthcheck timeout")
+
+	cfg.Nodes = nil
+
+	_, err = cfg.InitParameters()
+	require.Error(t, err)
+}
@@ -0,0 +1,254 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"go.uber.org/zap"
+)
+
+// NetmapSource provides the current NeoFS network map.
+//
+// NeoFS API protocol supported by the client does not provide a method to read
+// the network map from the storage nodes, so the source is specified by the
+// application (e.g. it may read the Netmap contract from the sidechain).
+type NetmapSource interface {
+	// NetMap returns descriptors of the storage nodes from the current network map.
+	NetMap(ctx context.Context) ([]netmap.NodeInfo, error)
+}
+
+// NodeDiscoveryParameters groups parameters of the automatic node discovery.
+//
+// See also InitParameters.SetNodeDiscovery.
+type NodeDiscoveryParameters struct {
+	source NetmapSource
+
+	groups []priorityGroup
+
+	defaultPrioritySet bool
+	defaultPriority    int
+}
+
+// priorityGroup describes the nodes having the attribute with the particular value.
+type priorityGroup struct {
+	priority int
+	key      string
+	value    string
+}
+
+// SetNetmapSource specifies the source of the network map to discover the nodes from.
+// Required.
+func (x *NodeDiscoveryParameters) SetNetmapSource(src NetmapSource) {
+	x.source = src
+}
+
+// AddPriorityGroup specifies priority of the discovered nodes which have the
+// attribute with the given key and value (e.g. netmap.AttrContinent and "Europe").
+// If a node belongs to several groups, the lowest priority value is used.
+// Priorities are compared with the ones of the bootstrap nodes in the same way
+// as for the nodes added via InitParameters.AddNode, so they can be used
+// to prefer the nodes located closer to the application.
+func (x *NodeDiscoveryParameters) AddPriorityGroup(priority int, key, value string) {
+	x.groups = append(x.groups, priorityGroup{
+		priority: priority,
+		key:      key,
+		value:    value,
+	})
+}
+
+// SetDefaultPriority specifies priority of the discovered nodes which don't
+// belong to any priority group. If not set, it is one more than the highest
+// priority value of the groups and bootstrap nodes.
+func (x *NodeDiscoveryParameters) SetDefaultPriority(priority int) {
+	x.defaultPriority = priority
+	x.defaultPrioritySet = true
+}
+
+// nodeDiscovery keeps the state of the node discovery.
+type nodeDiscovery struct {
+	prm NodeDiscoveryParameters
+
+	// bootstrap nodes which are never removed
+	bootstrap []NodeParam
+
+	// last processed epoch, zero means that the nodes have not been discovered yet
+	epoch uint64
+}
+
+// discoveredNodeWeight is a weight of the discovered nodes within the priority group.
+const discoveredNodeWeight = 1
+
+func newNodeDiscovery(prm NodeDiscoveryParameters, bootstrap []NodeParam) (*nodeDiscovery, error) {
+	if prm.source == nil {
+		return nil, errors.New("missing netmap source")
+	}
+
+	if !prm.defaultPrioritySet {
+		priorities := make([]int, 0, len(prm.groups)+len(bootstrap))
+
+		for i := range prm.groups {
+			priorities = append(priorities, prm.groups[i].priority)
+		}
+
+		for i := range bootstrap {
+			priorities = append(priorities, bootstrap[i].priority)
+		}
+
+		for i := range priorities {
+			if i == 0 || priorities[i] >= prm.defaultPriority {
+				prm.defaultPriority = priorities[i] + 1
+			}
+		}
+	}
+
+	return &nodeDiscovery{
+		prm:       prm,
+		bootstrap: bootstrap,
+	}, nil
+}
+
+// priority returns priority of the node according to the configured groups.
+func (x *nodeDiscovery) priority(info netmap.NodeInfo) int {
+	res, matched := x.prm.defaultPriority, false
+
+	for _, attr := range info.Attributes() {
+		for i := range x.prm.groups {
+			if attr.Key() != x.prm.groups[i].key || attr.Value() != x.prm.groups[i].value {
+				continue
+			}
+
+			if !matched || x.prm.groups[i].priority < res {
+				res, matched = x.prm.groups[i].priority, true
+			}
+		}
+	}
+
+	return res
+}
+
+// nodeParams forms full list of the Pool nodes: bootstrap ones and the ones
+// from the network map.
+func (x *nodeDiscovery) nodeParams(nodes []netmap.NodeInfo) []NodeParam {
+	res := make([]NodeParam, 0, len(x.bootstrap)+len(nodes))
+	res = append(res, x.bootstrap...)
+
+	seen := make(map[string]struct{}, cap(res))
+	for i := range x.bootstrap {
+		seen[x.bootstrap[i].address] = struct{}{}
+	}
+
+	for i := range nodes {
+		if nodes[i].State() == netmap.NodeStateOffline {
+			continue
+		}
+
+		var endpoint string
+
+		nodes[i].IterateAddresses(func(addr string) bool {
+			var err error
+
+			endpoint, err = nodeEndpoint(addr)
+
+			return err == nil
+		})
+
+		if endpoint == "" {
+			continue
+		}
+
+		if _, ok := seen[endpoint]; ok {
+			continue
+		}
+
+		seen[endpoint] = struct{}{}
+
+		res = append(res, NewNodeParam(x.priority(nodes[i]), endpoint, discoveredNodeWeight))
+	}
+
+	return res
+}
+
+// discoverNodes updates the set of the Pool nodes according to the network map
+// if the epoch has been changed since the last discovery. Errors are logged.
+func (p *Pool) discoverNodes(ctx context.Context) {
+	ni, err := p.NetworkInfo(ctx)
+	if err != nil {
+		p.logDiscoveryError("read network info", err)
+		return
+	}
+
+	epoch := ni.CurrentEpoch()
+	if p.discovery.epoch != 0 && p.discovery.epoch == epoch {
+		return
+	}
+
+	nodes, err := p.discovery.prm.source.NetMap(ctx)
+	if err != nil {
+		p.logDiscoveryError("read network map", err)
+		return
+	}
+
+	err = p.updateNodes(ctx, p.discovery.nodeParams(nodes))
+	if err != nil {
+		p.logDiscoveryError("update nodes", err)
+		return
+	}
+
+	if p.placement != nil {
+		if err = p.placement.updateNetmap(nodes); err != nil {
+			p.logDiscoveryError("update placement network map", err)
+			return
+		}
+	}
+
+	p.discovery.epoch = epoch
+}
+
+func (p *Pool) logDiscoveryError(msg string, err error) {
+	if p.logger != nil {
+		p.logger.Warn("node discovery: "+msg, zap.Error(err))
+	}
+}
+
+// nodeEndpoint converts network address of the node from the network map
+// to the endpoint accepted by the client. Supported multiaddress formats:
+//   /ip4/<host>/tcp/<port>[/tls]
+//   /ip6/<host>/tcp/<port>[/tls]
+//   /dns[4|6]/<host>/tcp/<port>[/tls]
+// Addresses which are not multiaddresses are returned as is.
+func nodeEndpoint(addr string) (string, error) {
+	if !strings.HasPrefix(addr, "/") {
+		return addr, nil
+	}
+
+	parts := strings.Split(addr[1:], "/")
+	if len(parts) != 4 && len(parts) != 5 {
+		return "", fmt.Errorf("unsupported multiaddress %s", addr)
+	}
+
+	switch parts[0] {
+	case "ip4", "ip6", "dns", "dns4", "dns6":
+	default:
+		return "", fmt.Errorf("unsupported network protocol %s", parts[0])
+	}
+
+	if parts[2] != "tcp" {
+		return "", fmt.Errorf("unsupported transport protocol %s", parts[2])
+	}
+
+	endpoint := net.JoinHostPort(parts[1], parts[3])
+
+	if len(parts) == 5 {
+		if parts[4] != "tls" {
+			return "", fmt.Errorf("unsupported multiaddress %s", addr)
+		}
+
+		return "grpcs://" + endpoint, nil
+	}
+
+	return endpoint, nil
+}
@@ -0,0 +1,157 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+type netmapSourceMock struct {
+	nodes []netmap.NodeInfo
+}
+
+func (x *netmapSourceMock) NetMap(context.Context) ([]netmap.NodeInfo, error) {
+	return x.nodes, nil
+}
+
+func newNodeInfo(addr string, attrs ...string) netmap.NodeInfo {
+	var ni netmap.NodeInfo
+	ni.SetAddresses(addr)
+	ni.SetState(netmap.NodeStateOnline)
+
+	as := make([]netmap.NodeAttribute, 0, len(attrs)/2)
+	for i := 0; i < len(attrs); i += 2 {
+		var a netmap.NodeAttribute
+		a.SetKey(attrs[i])
+		a.SetValue(attrs[i+1])
+		as = append(as, a)
+	}
+
+	ni.SetAttributes(as...)
+
+	return ni
+}
+
+func TestNodeEndpoint(t *testing.T) {
+	for _, tc := range []struct {
+		addr     string
+		endpoint string
+	}{
+		{addr: "s01.neofs.devenv:8080", endpoint: "s01.neofs.devenv:8080"},
+		{addr: "/dns4/s01.neofs.devenv/tcp/8080", endpoint: "s01.neofs.devenv:8080"},
+		{addr: "/ip4/10.78.70.1/tcp/8080/tls", endpoint: "grpcs://10.78.70.1:8080"},
+		{addr: "/ip6/::1/tcp/8080", endpoint: "[::1]:8080"},
+	} {
+		endpoint, err := nodeEndpoint(tc.addr)
+		require.NoError(t, err, tc.addr)
+		require.Equal(t, tc.endpoint, endpoint)
+	}
+
+	for _, addr := range []string{
+		"/dns4/s01.neofs.devenv/udp/8080",
+		"/unix/tmp/neofs.sock",
+		"/ip4/10.78.70.1/tcp/8080/http",
+		"/ip4/10.78.70.1",
+	} {
+		_, err := nodeEndpoint(addr)
+		require.Error(t, err, addr)
+	}
+}
+
+func TestNodeDiscovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var epoch uint64 = 1
+	closed := make(map[string]bool)
+
+	clientBuilder := func(endpoint string) (client, error) {
+		mockClient := NewMockClient(ctrl)
+		mockClient.EXPECT().sessionCreate(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}) (*resCreateSession, error) {
+			tok := newToken(t)
+			id := tok.ID()
+			return &resCreateSession{
+				id:         id[:],
+				sessionKey: newBinPublicKey(t),
+			}, nil
+		}).AnyTimes()
+		mockClient.EXPECT().endpointInfo(gomock.Any(), gomock.Any()).Return(&netmap.NodeInfo{}, nil).AnyTimes()
+		mockClient.EXPECT().networkInfo(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}) (*netmap.NetworkInfo, error) {
+			ni := netmap.NewNetworkInfo()
+			ni.SetCurrentEpoch(epoch)
+			return ni, nil
+		}).AnyTimes()
+		mockClient.EXPECT().close().DoAndReturn(func() error {
+			closed[endpoint] = true
+			return nil
+		}).AnyTimes()
+		return mockClient, nil
+	}
+
+	src := &netmapSourceMock{
+		nodes: []netmap.NodeInfo{
+			newNodeInfo("/dns4/node1/tcp/8080", netmap.AttrContinent, "Europe"),
+			newNodeInfo("/dns4/node2/tcp/8080", netmap.AttrContinent, "Asia"),
+			newNodeInfo("peer0"),
+		},
+	}
+
+	var prmDiscovery NodeDiscoveryParameters
+	prmDiscovery.SetNetmapSource(src)
+	prmDiscovery.AddPriorityGroup(2, netmap.AttrContinent, "Europe")
+
+	opts := InitParameters{
+		signer:                  neofscryptotest.Signer(),
+		nodeParams:              []NodeParam{{1, "peer0", 1}},
+		clientRebalanceInterval: time.Hour,
+		clientBuilder:           clientBuilder,
+	}
+	opts.SetNodeDiscovery(prmDiscovery)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(ctx))
+	t.Cleanup(pool.Close)
+
+	require.ElementsMatch(t, []NodeParam{
+		{1, "peer0", 1},
+		{2, "node1:8080", 1},
+		{3, "node2:8080", 1},
+	}, pool.nodeParams)
+	require.Len(t, pool.innerPools, 3)
+
+	t.Run("same epoch", func(t *testing.T) {
+		src.nodes = src.nodes[:1]
+
+		pool.discoverNodes(ctx)
+		require.Len(t, pool.nodeParams, 3)
+		require.Empty(t, closed)
+	})
+
+	t.Run("new epoch", func(t *testing.T) {
+		epoch++
+
+		_, peer0 := pool.findNode("peer0")
+		require.NotNil(t, peer0)
+
+		pool.discoverNodes(ctx)
+
+		// state of the known nodes is kept, so updates via the held pointers
+		// are not lost
+		_, cp := pool.findNode("peer0")
+		require.Same(t, peer0, cp)
+		require.ElementsMatch(t, []NodeParam{
+			{1, "peer0", 1},
+			{2, "node1:8080", 1},
+		}, pool.nodeParams)
+		require.Len(t, pool.innerPools, 2)
+		require.Equal(t, map[string]bool{"node2:8080": true}, closed)
+	})
+}
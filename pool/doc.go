@@ -19,6 +19,69 @@ This InitParameters will make pool use 192.168.130.71 node while it is healthy.
 	p, err := pool.NewPool(prm)
 	// ...
 
+Pool parameters may also be loaded from the configuration file overlaid by
+the environment variables:
+	var cfg pool.Config
+	err := yaml.Unmarshal(data, &cfg)
+	// ...
+
+	err = cfg.ReadEnv("NEOFS")
+	// ...
+
+	prm, err := cfg.InitParameters()
+	// ...
+
+Nodes may be discovered automatically from the network map. In this case,
+the nodes added via AddNode are used for bootstrap, and the pool follows the
+network map changes on each new epoch. Nodes from Europe are preferred here:
+	var prmDiscovery pool.NodeDiscoveryParameters
+	prmDiscovery.SetNetmapSource(src)
+	prmDiscovery.AddPriorityGroup(2, netmap.AttrContinent, "Europe")
+
+	prm.SetNodeDiscovery(prmDiscovery)
+
+With the discovered network map, the pool can also send object reading requests
+directly to the nodes which store the object:
+	prm.EnablePlacementRouting()
+
+Failed operations may be retried on other nodes. Idempotent operations
+are retried after transport failures by default:
+	var retry pool.RetryPolicy
+	retry.SetMaxAttempts(3)
+	retry.SetBackoff(100*time.Millisecond, time.Second)
+
+	prm.SetRetryPolicy(retry)
+
+Nodes which fail too often may be quarantined before the next health check:
+	prm.SetErrorThreshold(5)
+	prm.SetQuarantineDuration(time.Minute)
+
+Node weights within the group may be adjusted by the node latencies, so faster
+nodes receive more requests:
+	prm.SetLatencyWeighting(0.2, 0.1)
+
+Requests may be limited both for the whole pool and for each node. Requests
+exceeding the limits wait for the capacity by default:
+	var limits pool.Limits
+	limits.SetMaxConcurrentStreams(10)
+	limits.SetRequestRate(pool.OperationClassPut, 100)
+	limits.SetPayloadRate(10 << 20)
+
+	prm.SetNodeLimits(limits)
+
+Object reads may be hedged: if the node doesn't respond within the delay,
+the request is also sent to another node and the first response is taken:
+	prm.SetHedging(50*time.Millisecond, 2)
+
+Parts of the big object may be written through different nodes within the
+same session:
+	prm.EnableSharedSessions()
+
+Subscribe to the node health changes:
+	prm.SetNodeHealthHandler(func(e pool.NodeHealthEvent) {
+		log.Printf("node %s healthy: %t, cause: %s", e.Address(), e.Healthy(), e.Cause())
+	})
+
 Connect to the NeoFS server:
 	err := p.Dial(ctx)
 	// ...
@@ -42,8 +105,69 @@ Execute NeoFS operation on the server and check error:
 	}
 	// ...
 
+Big objects may be uploaded by parts in parallel. Upload state can be saved
+to resume the upload after restart:
+	upload, err := p.InitMultipartUpload(hdr)
+	// ...
+
+	var prmPart pool.PrmPartPut
+	prmPart.SetNumber(1)
+	prmPart.SetPayload(part)
+
+	_, err = p.PutPart(ctx, upload, prmPart)
+	// ...
+
+	state, err := upload.MarshalJSON()
+	// ...
+
+	id, err := p.CompleteMultipartUpload(ctx, upload, pool.PrmMultipartComplete{})
+	// ...
+
+Broken payload streams may be resumed from the current offset on another node:
+	var prmGet pool.PrmObjectGet
+	prmGet.SetAddress(addr)
+	prmGet.SetMaxResumes(3)
+
+	res, err := p.GetObject(ctx, prmGet)
+	// ...
+
+Big objects may be downloaded by payload ranges read concurrently from
+different nodes:
+	var prmDownload pool.PrmObjectDownload
+	prmDownload.SetAddress(addr)
+	prmDownload.SetParallelism(8)
+
+	hdr, err := p.DownloadObject(ctx, prmDownload, file)
+	// ...
+
+Nodes may be added to and removed from the connected pool. Node can be drained
+before removal to let the running requests finish:
+	err = p.AddNode(ctx, pool.NewNodeParam(1, "localhost:8081", 1))
+	// ...
+	err = p.DrainNode("localhost:8080")
+	// ...
+	err = p.RemoveNode("localhost:8080")
+
+Inspect per-node statistics of the executed operations, e.g. to report them
+to the logger:
+	for _, node := range p.Statistic().Nodes() {
+		logger.Info("pool node statistic",
+			zap.String("address", node.Address()),
+			zap.Bool("healthy", node.Healthy()),
+			zap.Uint64("requests", node.Requests()),
+			zap.Uint64("errors", node.Errors()),
+		)
+	}
+
 Close the connection:
 	p.Close()
 
+Or wait for the running operations and open streams to finish before closing:
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := p.Shutdown(ctx)
+	// ...
+
 */
 package pool
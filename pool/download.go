@@ -0,0 +1,269 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+const (
+	defaultDownloadParallelism = 4
+	defaultDownloadChunkSize   = 4 << 20
+	defaultDownloadAttempts    = 3
+)
+
+// PrmObjectDownload groups parameters of DownloadObject operation.
+type PrmObjectDownload struct {
+	prmCommon
+
+	addr oid.Address
+
+	parallelism int
+	chunkSize   uint64
+	attempts    int
+
+	skipChecksum bool
+}
+
+// SetAddress specifies NeoFS address of the object.
+func (x *PrmObjectDownload) SetAddress(addr oid.Address) {
+	x.addr = addr
+}
+
+// SetParallelism specifies the maximum number of the payload ranges read
+// concurrently. Defaults to 4.
+func (x *PrmObjectDownload) SetParallelism(n int) {
+	x.parallelism = n
+}
+
+// SetChunkSize specifies size of the payload ranges in bytes. Defaults to 4MB.
+func (x *PrmObjectDownload) SetChunkSize(size uint64) {
+	x.chunkSize = size
+}
+
+// SetRangeAttempts specifies the maximum number of reads of each payload range
+// including the first one. Defaults to 3.
+func (x *PrmObjectDownload) SetRangeAttempts(n int) {
+	x.attempts = n
+}
+
+// SkipChecksumVerification disables the check of the downloaded payload
+// against the header's payload checksum. By default, DownloadObject fails if
+// the header has no SHA-256 payload checksum.
+func (x *PrmObjectDownload) SkipChecksumVerification() {
+	x.skipChecksum = true
+}
+
+// downloadChunk is a payload range read by the DownloadObject worker.
+type downloadChunk struct {
+	index int
+	off   uint64
+	buf   []byte
+	err   error
+}
+
+// DownloadObject reads the object header and then the payload by ranges
+// concurrently. Each range request is routed independently, so the ranges
+// are spread over the healthy nodes. Failed ranges are retried separately.
+// Payload is written to w, ranges are written in an arbitrary order. The full
+// payload is checked against the header's SHA-256 payload checksum unless
+// verification is disabled by PrmObjectDownload.SkipChecksumVerification.
+//
+// Returns the object header. On error, w may contain the partially written
+// payload.
+func (p *Pool) DownloadObject(ctx context.Context, prm PrmObjectDownload, w io.WriterAt) (*object.Object, error) {
+	if prm.parallelism <= 0 {
+		prm.parallelism = defaultDownloadParallelism
+	}
+
+	if prm.chunkSize == 0 {
+		prm.chunkSize = defaultDownloadChunkSize
+	}
+
+	if prm.attempts <= 0 {
+		prm.attempts = defaultDownloadAttempts
+	}
+
+	var prmHead PrmObjectHead
+	prmHead.prmCommon = prm.prmCommon
+	prmHead.SetAddress(prm.addr)
+
+	hdr, err := p.HeadObject(ctx, prmHead)
+	if err != nil {
+		return nil, fmt.Errorf("read object header: %w", err)
+	}
+
+	// payload hasher, nil if verification is disabled
+	var (
+		h  *checksum.Hasher
+		cs checksum.Checksum
+	)
+
+	if !prm.skipChecksum {
+		var ok bool
+
+		cs, ok = hdr.PayloadChecksum()
+		if !ok {
+			return nil, errors.New("missing payload checksum in the object header")
+		}
+
+		if cs.Type() != checksum.SHA256 {
+			return nil, fmt.Errorf("unsupported payload checksum type %v", cs.Type())
+		}
+
+		h = checksum.NewHasher(checksum.SHA256)
+	}
+
+	size := hdr.PayloadSize()
+	n := int((size + prm.chunkSize - 1) / prm.chunkSize)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// chunks are hashed in order, so the number of the read but not hashed
+	// chunks is limited to bound the memory
+	window := 2 * prm.parallelism
+
+	jobs := make(chan downloadChunk)
+	results := make(chan downloadChunk, window)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < prm.parallelism && i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for c := range jobs {
+				c.err = p.downloadRange(ctx, prm, c.off, c.buf, w)
+				results <- c
+			}
+		}()
+	}
+
+	var (
+		pending = make(map[int][]byte, window)
+		free    [][]byte
+		next    int
+		hashed  int
+		job     downloadChunk
+		ready   bool // job is prepared but not sent yet
+	)
+
+	for hashed < n && err == nil {
+		var jobCh chan<- downloadChunk
+
+		if !ready && next < n && next-hashed < window {
+			job = downloadChunk{index: next, off: uint64(next) * prm.chunkSize}
+
+			ln := prm.chunkSize
+			if rest := size - job.off; rest < ln {
+				ln = rest
+			}
+
+			if len(free) > 0 {
+				job.buf = free[len(free)-1][:ln]
+				free = free[:len(free)-1]
+			} else {
+				job.buf = make([]byte, ln, prm.chunkSize)
+			}
+
+			ready = true
+		}
+
+		if ready {
+			jobCh = jobs
+		}
+
+		select {
+		case jobCh <- job:
+			ready = false
+			next++
+		case res := <-results:
+			if res.err != nil {
+				err = res.err
+				break
+			}
+
+			pending[res.index] = res.buf
+
+			for buf, ok := pending[hashed]; ok; buf, ok = pending[hashed] {
+				if h != nil {
+					_, _ = h.Write(buf)
+				}
+
+				delete(pending, hashed)
+				free = append(free, buf)
+				hashed++
+			}
+		}
+	}
+
+	close(jobs)
+
+	if err != nil {
+		cancel()
+	}
+
+	wg.Wait()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if h != nil && !bytes.Equal(cs.Value(), h.Checksum().Value()) {
+		return nil, errors.New("payload checksum mismatch")
+	}
+
+	return hdr, nil
+}
+
+// downloadRange reads the payload range into buf and writes it to w. The range
+// is read again on failure according to the attempts of the operation.
+func (p *Pool) downloadRange(ctx context.Context, prm PrmObjectDownload, off uint64, buf []byte, w io.WriterAt) error {
+	var prmRange PrmObjectRange
+	prmRange.prmCommon = prm.prmCommon
+	prmRange.SetAddress(prm.addr)
+	prmRange.SetOffset(off)
+	prmRange.SetLength(uint64(len(buf)))
+
+	for attempt := 1; ; attempt++ {
+		err := p.readRange(ctx, prmRange, buf)
+		if err == nil {
+			break
+		}
+
+		if ctx.Err() != nil || attempt >= prm.attempts {
+			return fmt.Errorf("read payload range [%d:%d]: %w", off, off+uint64(len(buf)), err)
+		}
+	}
+
+	if _, err := w.WriteAt(buf, int64(off)); err != nil {
+		return fmt.Errorf("write payload range [%d:%d]: %w", off, off+uint64(len(buf)), err)
+	}
+
+	return nil
+}
+
+// readRange reads the full payload range into buf.
+func (p *Pool) readRange(ctx context.Context, prm PrmObjectRange, buf []byte) error {
+	res, err := p.ObjectRange(ctx, prm)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.ReadFull(res, buf)
+	if errClose := res.Close(); err == nil {
+		err = errClose
+	}
+
+	return err
+}
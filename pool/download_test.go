@@ -0,0 +1,162 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+// rangeReaderMock is an objectRangeReader of the in-memory payload.
+type rangeReaderMock struct {
+	io.Reader
+}
+
+func (rangeReaderMock) Close() (*sdkClient.ResObjectRange, error) {
+	return nil, nil
+}
+
+// writerAtMock is an io.WriterAt to the in-memory buffer.
+type writerAtMock struct {
+	mtx sync.Mutex
+	buf []byte
+}
+
+func (x *writerAtMock) WriteAt(p []byte, off int64) (int, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if end := int(off) + len(p); end > len(x.buf) {
+		x.buf = append(x.buf, make([]byte, end-len(x.buf))...)
+	}
+
+	return copy(x.buf[off:], p), nil
+}
+
+func TestPoolDownloadObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	payload := make([]byte, 1000)
+	rand.Read(payload)
+
+	hdr := object.New()
+	hdr.SetPayloadSize(uint64(len(payload)))
+
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.SHA256, payload)
+	hdr.SetPayloadChecksum(cs)
+
+	var (
+		mtx      sync.Mutex
+		requests = make(map[uint64]int)
+		failOff  = uint64(300) // first read of this range fails
+		corrupt  bool
+	)
+
+	node := newSessionMock(t, ctrl)
+	node.EXPECT().objectHead(gomock.Any(), gomock.Any()).Return(hdr, nil).AnyTimes()
+	node.EXPECT().objectRange(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectRange) (*ResObjectRange, error) {
+		mtx.Lock()
+		requests[prm.off]++
+		attempt := requests[prm.off]
+		mtx.Unlock()
+
+		if prm.off == failOff && attempt == 1 {
+			return nil, errors.New("connection refused")
+		}
+
+		data := append([]byte(nil), payload[prm.off:prm.off+prm.ln]...)
+		if corrupt {
+			data[0]++
+		}
+
+		return &ResObjectRange{payload: rangeReaderMock{bytes.NewReader(data)}}, nil
+	}).AnyTimes()
+
+	p := &Pool{
+		innerPools: []*innerPool{{
+			sampler:     newSampler([]float64{1}, rand.NewSource(0)),
+			clientPacks: []*clientPack{{client: node, healthy: true, address: "node"}},
+		}},
+		cache:  cache,
+		signer: neofscryptotest.Signer(),
+	}
+
+	var prm PrmObjectDownload
+	prm.SetAddress(oidtest.Address())
+	prm.SetChunkSize(100)
+	prm.SetParallelism(3)
+
+	var w writerAtMock
+
+	res, err := p.DownloadObject(context.Background(), prm, &w)
+	require.NoError(t, err)
+	require.Equal(t, hdr, res)
+	require.Equal(t, payload, w.buf)
+
+	require.Len(t, requests, 10)
+	for off, n := range requests {
+		if off == failOff {
+			require.Equal(t, 2, n)
+		} else {
+			require.Equal(t, 1, n)
+		}
+	}
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		requests = make(map[uint64]int)
+		prm.SetRangeAttempts(1)
+
+		_, err := p.DownloadObject(context.Background(), prm, new(writerAtMock))
+		require.Error(t, err)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		failOff = uint64(len(payload))
+		corrupt = true
+
+		_, err := p.DownloadObject(context.Background(), prm, new(writerAtMock))
+		require.Error(t, err)
+	})
+
+	t.Run("unverifiable checksum", func(t *testing.T) {
+		corrupt = false
+
+		var csTZ checksum.Checksum
+		checksum.Calculate(&csTZ, checksum.TZ, payload)
+
+		noChecksum := object.New()
+		noChecksum.SetPayloadSize(uint64(len(payload)))
+		*hdr = *noChecksum
+
+		_, err := p.DownloadObject(context.Background(), prm, new(writerAtMock))
+		require.Error(t, err)
+
+		hdr.SetPayloadChecksum(csTZ)
+
+		_, err = p.DownloadObject(context.Background(), prm, new(writerAtMock))
+		require.Error(t, err)
+
+		prm.SkipChecksumVerification()
+
+		var w writerAtMock
+
+		_, err = p.DownloadObject(context.Background(), prm, &w)
+		require.NoError(t, err)
+		require.Equal(t, payload, w.buf)
+	})
+}
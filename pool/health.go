@@ -0,0 +1,129 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// NodeHealthCause enumerates causes of the node health changes.
+type NodeHealthCause uint8
+
+const (
+	_ NodeHealthCause = iota
+	// HealthCauseDialFailure means that the node failed to open the session
+	// while being dialed.
+	HealthCauseDialFailure
+	// HealthCauseHealthcheckFailure means that the node health check failed.
+	HealthCauseHealthcheckFailure
+	// HealthCauseHealthcheckTimeout means that the node didn't respond to the
+	// health check in time.
+	HealthCauseHealthcheckTimeout
+	// HealthCauseHealthcheckSuccess means that the node health check
+	// succeeded.
+	HealthCauseHealthcheckSuccess
+	// HealthCauseErrorThreshold means that the node has exceeded the error
+	// threshold and has been quarantined.
+	HealthCauseErrorThreshold
+	// HealthCauseDrained means that the node has been drained.
+	HealthCauseDrained
+
+	// healthCauseLast is a number of causes, must be the last one.
+	healthCauseLast
+)
+
+var healthCauseNames = [healthCauseLast]string{
+	HealthCauseDialFailure:        "DialFailure",
+	HealthCauseHealthcheckFailure: "HealthcheckFailure",
+	HealthCauseHealthcheckTimeout: "HealthcheckTimeout",
+	HealthCauseHealthcheckSuccess: "HealthcheckSuccess",
+	HealthCauseErrorThreshold:     "ErrorThreshold",
+	HealthCauseDrained:            "Drained",
+}
+
+// String returns name of the cause.
+func (x NodeHealthCause) String() string {
+	if x > 0 && x < healthCauseLast {
+		return healthCauseNames[x]
+	}
+
+	return "Unknown"
+}
+
+// NodeHealthEvent describes change of the node health.
+//
+// See also InitParameters.SetNodeHealthHandler.
+type NodeHealthEvent struct {
+	address    string
+	wasHealthy bool
+	healthy    bool
+	cause      NodeHealthCause
+	epoch      uint64
+	err        error
+}
+
+// Address returns address of the node.
+func (x NodeHealthEvent) Address() string {
+	return x.address
+}
+
+// WasHealthy returns health status of the node before the change. Always
+// false for the node which failed on dial.
+func (x NodeHealthEvent) WasHealthy() bool {
+	return x.wasHealthy
+}
+
+// Healthy returns current health status of the node.
+func (x NodeHealthEvent) Healthy() bool {
+	return x.healthy
+}
+
+// Cause returns cause of the change.
+func (x NodeHealthEvent) Cause() NodeHealthCause {
+	return x.cause
+}
+
+// Epoch returns the latest NeoFS epoch known to the Pool at the moment of the
+// change. Zero if the epoch is not known yet.
+func (x NodeHealthEvent) Epoch() uint64 {
+	return x.epoch
+}
+
+// Err returns error caused the change if any.
+func (x NodeHealthEvent) Err() error {
+	return x.err
+}
+
+// healthcheckCause returns cause of the health check result. Context is the
+// one the health check was performed with.
+func healthcheckCause(ctx context.Context, err error) NodeHealthCause {
+	switch {
+	case err == nil:
+		return HealthCauseHealthcheckSuccess
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return HealthCauseHealthcheckTimeout
+	default:
+		return HealthCauseHealthcheckFailure
+	}
+}
+
+// notifyHealth passes the node health event to the handler if it's set.
+func (p *Pool) notifyHealth(address string, wasHealthy, healthy bool, cause NodeHealthCause, err error) {
+	if p.healthHandler == nil {
+		return
+	}
+
+	var epoch uint64
+	if p.cache != nil {
+		epoch = atomic.LoadUint64(&p.cache.currentEpoch)
+	}
+
+	p.healthHandler(NodeHealthEvent{
+		address:    address,
+		wasHealthy: wasHealthy,
+		healthy:    healthy,
+		cause:      cause,
+		epoch:      epoch,
+		err:        err,
+	})
+}
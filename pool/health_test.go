@@ -0,0 +1,88 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthcheckCause(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	require.Equal(t, HealthCauseHealthcheckSuccess, healthcheckCause(ctx, nil))
+	require.Equal(t, HealthCauseHealthcheckTimeout, healthcheckCause(ctx, errors.New("any error")))
+	require.Equal(t, HealthCauseHealthcheckFailure, healthcheckCause(context.Background(), errors.New("any error")))
+
+	require.Equal(t, "HealthcheckTimeout", HealthCauseHealthcheckTimeout.String())
+	require.Equal(t, "Unknown", NodeHealthCause(0).String())
+}
+
+func TestNodeHealthHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	errHealthcheck := errors.New("healthcheck failure")
+
+	failed := NewMockClient(ctrl)
+	failed.EXPECT().endpointInfo(gomock.Any(), gomock.Any()).Return(nil, errHealthcheck).AnyTimes()
+
+	healthy := NewMockClient(ctrl)
+	healthy.EXPECT().endpointInfo(gomock.Any(), gomock.Any()).Return(&netmap.NodeInfo{}, nil).AnyTimes()
+
+	cache, err := newCache()
+	require.NoError(t, err)
+	cache.updateEpoch(5)
+
+	var mtx sync.Mutex
+	var events []NodeHealthEvent
+
+	weights := []float64{0.5, 0.5}
+
+	p := &Pool{
+		innerPools: []*innerPool{{
+			sampler: newSampler(weights, rand.NewSource(0)),
+			clientPacks: []*clientPack{
+				{client: failed, healthy: true, address: "failed"},
+				{client: healthy, healthy: true, address: "healthy"},
+			},
+			weights: weights,
+		}},
+		cache: cache,
+		rebalanceParams: rebalanceParameters{
+			nodesParams:        []*nodesParam{{weights: weights}},
+			nodeRequestTimeout: time.Second,
+		},
+		healthHandler: func(e NodeHealthEvent) {
+			mtx.Lock()
+			events = append(events, e)
+			mtx.Unlock()
+		},
+	}
+
+	p.updateNodesHealth(context.Background())
+
+	require.Len(t, events, 1)
+	require.Equal(t, "failed", events[0].Address())
+	require.True(t, events[0].WasHealthy())
+	require.False(t, events[0].Healthy())
+	require.Equal(t, HealthCauseHealthcheckFailure, events[0].Cause())
+	require.EqualValues(t, 5, events[0].Epoch())
+	require.ErrorIs(t, events[0].Err(), errHealthcheck)
+
+	// nothing changed
+	p.updateNodesHealth(context.Background())
+	require.Len(t, events, 1)
+
+	require.NoError(t, p.DrainNode("healthy"))
+	require.Len(t, events, 2)
+	require.Equal(t, "healthy", events[1].Address())
+	require.Equal(t, HealthCauseDrained, events[1].Cause())
+	require.NoError(t, events[1].Err())
+}
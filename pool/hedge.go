@@ -0,0 +1,174 @@
+package pool
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// hedgingParameters groups parameters of the hedged reads.
+type hedgingParameters struct {
+	delay     time.Duration
+	maxHedges int
+}
+
+func (x hedgingParameters) enabled() bool {
+	return x.maxHedges > 0
+}
+
+// hedgeResult is a result of the single hedged execution.
+type hedgeResult struct {
+	i   int
+	res interface{}
+	err error
+}
+
+// hedge calls f with the given call context. If hedging is enabled and f
+// doesn't finish within the hedging delay, f is additionally called on other
+// nodes, up to the configured number of hedges. Result of the first
+// successful execution is returned, other executions are cancelled. If all
+// executions fail, the first error is returned.
+//
+// Each execution gets its own copy of the call context, so f must not share
+// mutable state between the calls.
+//
+// Context of the successful execution is passed to keep along with the
+// result, keep must call cancel after the result is no longer needed. Nil
+// keep means that result doesn't depend on the context. Results of the other
+// executions which succeeded are passed to discard if it's set.
+func (p *Pool) hedge(cc *callContext, prmCtx prmContext, f func(*callContext) (interface{}, error),
+	keep func(res interface{}, cancel context.CancelFunc), discard func(res interface{})) (interface{}, error) {
+	if !p.hedging.enabled() {
+		return f(cc)
+	}
+
+	results := make(chan hedgeResult, p.hedging.maxHedges+1)
+	cancels := make([]context.CancelFunc, 0, p.hedging.maxHedges+1)
+	tried := make(map[string]struct{}, p.hedging.maxHedges+1)
+
+	launch := func(c callContext) {
+		var ctx context.Context
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithCancel(c.Context)
+		c.Context = ctx
+
+		i := len(cancels)
+		cancels = append(cancels, cancel)
+		tried[c.endpoint] = struct{}{}
+
+		go func() {
+			res, err := f(&c)
+			results <- hedgeResult{i: i, res: res, err: err}
+		}()
+	}
+
+	launch(*cc)
+
+	timer := time.NewTimer(p.hedging.delay)
+	defer timer.Stop()
+
+	var firstErr error
+	pending := 1
+
+	for {
+		select {
+		case r := <-results:
+			pending--
+
+			if r.err == nil {
+				for i := range cancels {
+					if i != r.i {
+						cancels[i]()
+					}
+				}
+
+				if keep != nil {
+					keep(r.res, cancels[r.i])
+				} else {
+					cancels[r.i]()
+				}
+
+				if discard != nil && pending > 0 {
+					go func(n int) {
+						for i := 0; i < n; i++ {
+							if r := <-results; r.err == nil {
+								discard(r.res)
+							}
+						}
+					}(pending)
+				}
+
+				return r.res, nil
+			}
+
+			if firstErr == nil {
+				firstErr = r.err
+			}
+
+			if pending == 0 {
+				for i := range cancels {
+					cancels[i]()
+				}
+
+				return nil, firstErr
+			}
+		case <-timer.C:
+			if len(cancels) > p.hedging.maxHedges {
+				continue
+			}
+
+			cp, err := p.hedgeConnection(cc, prmCtx, tried)
+			if err != nil {
+				if p.logger != nil {
+					p.logger.Debug("no node for hedged request", zap.Error(err))
+				}
+
+				continue
+			}
+
+			if p.logger != nil {
+				p.logger.Debug("send hedged request",
+					zap.Stringer("operation", cc.op),
+					zap.String("address", cp.address))
+			}
+
+			c := *cc
+			c.client = cp.client
+			c.endpoint = cp.address
+
+			launch(c)
+			pending++
+
+			timer.Reset(p.hedging.delay)
+		}
+	}
+}
+
+// hedgeConnection selects node for the hedged request which hasn't been tried
+// yet: from the placement vectors if placement routing is used, otherwise
+// from the node groups in priority order.
+func (p *Pool) hedgeConnection(ctx context.Context, prmCtx prmContext, exclude map[string]struct{}) (*clientPack, error) {
+	if prmCtx.placement && prmCtx.objSet && p.placement != nil {
+		cp, err := p.placementConnectionExcept(ctx, prmCtx.cnr, prmCtx.obj, exclude)
+		if err == nil {
+			return cp, nil
+		}
+	}
+
+	return p.connectionExcept(exclude)
+}
+
+// hedgedPayload releases context of the hedged object reading on Close.
+type hedgedPayload struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (x hedgedPayload) Close() error {
+	err := x.ReadCloser.Close()
+	x.cancel()
+	return err
+}
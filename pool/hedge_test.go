@@ -0,0 +1,102 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func newSessionMock(t *testing.T, ctrl *gomock.Controller) *MockClient {
+	c := NewMockClient(ctrl)
+	c.EXPECT().networkInfo(gomock.Any(), gomock.Any()).Return(&netmap.NetworkInfo{}, nil).AnyTimes()
+	c.EXPECT().sessionCreate(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}) (*resCreateSession, error) {
+		uid := uuid.New()
+		return &resCreateSession{
+			id:         uid[:],
+			sessionKey: newBinPublicKey(t),
+		}, nil
+	}).AnyTimes()
+
+	return c
+}
+
+func TestPoolHedging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	newPool := func(slow, fast client) *Pool {
+		return &Pool{
+			innerPools: []*innerPool{{
+				// sampler always selects the slow node first
+				sampler: newSampler([]float64{1, 0}, rand.NewSource(0)),
+				clientPacks: []*clientPack{
+					{client: slow, healthy: true, address: "slow"},
+					{client: fast, healthy: true, address: "fast"},
+				},
+			}},
+			cache:  cache,
+			signer: neofscryptotest.Signer(),
+			hedging: hedgingParameters{
+				delay:     10 * time.Millisecond,
+				maxHedges: 1,
+			},
+		}
+	}
+
+	var prm PrmObjectHead
+	prm.SetAddress(oid.Address{})
+
+	t.Run("fast node wins", func(t *testing.T) {
+		cancelled := make(chan struct{})
+
+		slow := newSessionMock(t, ctrl)
+		slow.EXPECT().objectHead(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, _ PrmObjectHead) (*object.Object, error) {
+			<-ctx.Done()
+			close(cancelled)
+			return nil, ctx.Err()
+		})
+
+		obj := object.New()
+
+		fast := newSessionMock(t, ctrl)
+		fast.EXPECT().objectHead(gomock.Any(), gomock.Any()).Return(obj, nil)
+
+		res, err := newPool(slow, fast).HeadObject(context.Background(), prm)
+		require.NoError(t, err)
+		require.Equal(t, obj, res)
+
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Fatal("slow request has not been cancelled")
+		}
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		slow := newSessionMock(t, ctrl)
+		slow.EXPECT().objectHead(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, PrmObjectHead) (*object.Object, error) {
+			time.Sleep(50 * time.Millisecond)
+			return nil, errors.New("slow failure")
+		})
+
+		fast := newSessionMock(t, ctrl)
+		fast.EXPECT().objectHead(gomock.Any(), gomock.Any()).Return(nil, errors.New("fast failure"))
+
+		_, err := newPool(slow, fast).HeadObject(context.Background(), prm)
+		// first failure is returned
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "fast failure")
+	})
+}
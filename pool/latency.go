@@ -0,0 +1,98 @@
+package pool
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// latencyWeighting groups parameters of the latency-aware node weighting.
+type latencyWeighting struct {
+	smoothing float64
+	floor     float64
+}
+
+func (x latencyWeighting) enabled() bool {
+	return x.smoothing > 0
+}
+
+// verify checks that parameters of the enabled weighting are in the
+// allowed ranges.
+func (x latencyWeighting) verify() error {
+	if x == (latencyWeighting{}) {
+		return nil
+	}
+
+	if !(x.smoothing > 0 && x.smoothing <= 1) {
+		return fmt.Errorf("smoothing factor %v is out of (0; 1]", x.smoothing)
+	}
+
+	if !(x.floor >= 0 && x.floor <= 1) {
+		return fmt.Errorf("floor share %v is out of [0; 1]", x.floor)
+	}
+
+	return nil
+}
+
+// latencyEstimator calculates exponentially weighted moving average of the
+// node latency.
+type latencyEstimator struct {
+	mtx sync.Mutex
+
+	set   bool
+	value float64 // in seconds
+}
+
+// observe registers latency of the successful request to the node.
+func (x *latencyEstimator) observe(d time.Duration, smoothing float64) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if !x.set {
+		x.value = d.Seconds()
+		x.set = true
+		return
+	}
+
+	x.value = smoothing*d.Seconds() + (1-smoothing)*x.value
+}
+
+// get returns current average latency. Returns false if there were no
+// observations.
+func (x *latencyEstimator) get() (time.Duration, bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	return time.Duration(x.value * float64(time.Second)), x.set
+}
+
+// latencyFactors calculates multipliers of the static node weights by their
+// latencies: the fastest node gets 1, others get a ratio of the fastest node
+// latency to their own, but not less than the floor. Nodes without
+// measurements get 1.
+func latencyFactors(latencies []time.Duration, measured []bool, floor float64) []float64 {
+	var fastest time.Duration
+
+	for i := range latencies {
+		if measured[i] && latencies[i] > 0 && (fastest == 0 || latencies[i] < fastest) {
+			fastest = latencies[i]
+		}
+	}
+
+	res := make([]float64, len(latencies))
+
+	for i := range latencies {
+		res[i] = 1
+
+		if !measured[i] || latencies[i] <= 0 || fastest <= 0 {
+			continue
+		}
+
+		res[i] = float64(fastest) / float64(latencies[i])
+		if res[i] < floor {
+			res[i] = floor
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,122 @@
+package pool
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyEstimator(t *testing.T) {
+	var x latencyEstimator
+
+	_, ok := x.get()
+	require.False(t, ok)
+
+	x.observe(100*time.Millisecond, 0.5)
+	d, ok := x.get()
+	require.True(t, ok)
+	require.Equal(t, 100*time.Millisecond, d)
+
+	x.observe(200*time.Millisecond, 0.5)
+	d, _ = x.get()
+	require.InDelta(t, float64(150*time.Millisecond), float64(d), float64(time.Microsecond))
+}
+
+func TestLatencyFactors(t *testing.T) {
+	factors := latencyFactors(
+		[]time.Duration{10 * time.Millisecond, 20 * time.Millisecond, time.Second, 0},
+		[]bool{true, true, true, false},
+		0.1,
+	)
+
+	require.Equal(t, []float64{1, 0.5, 0.1, 1}, factors)
+}
+
+func TestPoolLatencyWeighting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	newNode := func(address string, latency time.Duration) *clientPack {
+		c := NewMockClient(ctrl)
+		c.EXPECT().endpointInfo(gomock.Any(), gomock.Any()).Return(&netmap.NodeInfo{}, nil).AnyTimes()
+
+		cp := &clientPack{client: c, healthy: true, address: address, latency: new(latencyEstimator)}
+		cp.latency.observe(latency, 1)
+
+		return cp
+	}
+
+	weights := []float64{0.5, 0.5}
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	inner := &innerPool{
+		sampler: newSampler(weights, rand.NewSource(0)),
+		clientPacks: []*clientPack{
+			newNode("fast", 10*time.Millisecond),
+			newNode("slow", 40*time.Millisecond),
+		},
+		weights: weights,
+	}
+
+	p := &Pool{
+		innerPools: []*innerPool{inner},
+		cache:      cache,
+		rebalanceParams: rebalanceParameters{
+			nodesParams:        []*nodesParam{{weights: weights}},
+			nodeRequestTimeout: time.Second,
+			// health checks of the mocks are instant, so make them insignificant
+			latency: latencyWeighting{smoothing: 0.001, floor: 0.1},
+		},
+	}
+
+	p.updateNodesHealth(context.Background())
+
+	require.InDelta(t, 0.8, inner.weights[0], 0.01)
+	require.InDelta(t, 0.2, inner.weights[1], 0.01)
+
+	// successful requests are taken into account
+	p.rebalanceParams.latency.smoothing = 1
+	p.reportNodeResult("slow", 10*time.Millisecond, nil)
+	p.reportNodeResult("fast", time.Second, nil)
+	p.rebalanceParams.latency.smoothing = 0.001
+
+	p.updateNodesHealth(context.Background())
+
+	require.InDelta(t, 1/1.1, inner.weights[1], 0.01)
+}
+
+func TestNewPool_LatencyWeighting(t *testing.T) {
+	for _, tc := range []struct {
+		smoothing, floor float64
+		valid            bool
+	}{
+		{smoothing: 0, floor: 0, valid: true},
+		{smoothing: 0.5, floor: 0.1, valid: true},
+		{smoothing: 1, floor: 0, valid: true},
+		{smoothing: 1, floor: 1, valid: true},
+		{smoothing: 0, floor: 0.1},
+		{smoothing: -0.5, floor: 0.1},
+		{smoothing: 1.5, floor: 0.1},
+		{smoothing: 0.5, floor: -0.1},
+		{smoothing: 0.5, floor: 1.5},
+	} {
+		var prm InitParameters
+		prm.SetSigner(neofscryptotest.Signer())
+		prm.AddNode(NewNodeParam(1, "peer0", 1))
+		prm.SetLatencyWeighting(tc.smoothing, tc.floor)
+
+		_, err := NewPool(prm)
+		if tc.valid {
+			require.NoError(t, err, tc)
+		} else {
+			require.Error(t, err, tc)
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrLimitExceeded is returned by Pool operations if the configured limit
+// is exceeded and fail-fast mode is enabled.
+//
+// See also InitParameters.EnableFailFastLimits.
+var ErrLimitExceeded = errors.New("pool limit exceeded")
+
+// OperationClass enumerates classes of the object operations with separate
+// request rate limits.
+type OperationClass uint8
+
+const (
+	// OperationClassPut corresponds to Pool.PutObject.
+	OperationClassPut OperationClass = iota
+	// OperationClassGet corresponds to Pool.GetObject, Pool.HeadObject and
+	// Pool.ObjectRange.
+	OperationClassGet
+	// OperationClassSearch corresponds to Pool.SearchObjects.
+	OperationClassSearch
+
+	// operationClassLast is a number of classes, must be the last one.
+	operationClassLast
+)
+
+// operationClass returns class of the operation. Returns false if the
+// operation is not rate limited.
+func operationClass(op Operation) (OperationClass, bool) {
+	switch op {
+	case OperationObjectPut:
+		return OperationClassPut, true
+	case OperationObjectGet, OperationObjectHead, OperationObjectRange:
+		return OperationClassGet, true
+	case OperationObjectSearch:
+		return OperationClassSearch, true
+	default:
+		return 0, false
+	}
+}
+
+// streamsResult checks if the operation result is a stream which is read
+// after the operation returns.
+func (x Operation) streamsResult() bool {
+	return x == OperationObjectGet || x == OperationObjectRange || x == OperationObjectSearch
+}
+
+// Limits groups limits of the requests sent by Pool. Zero values mean no
+// limit.
+//
+// See also InitParameters.SetGlobalLimits, InitParameters.SetNodeLimits.
+type Limits struct {
+	maxStreams  int
+	requestRate [operationClassLast]float64
+	payloadRate float64
+}
+
+// SetMaxConcurrentStreams specifies maximum number of the object operations
+// executed simultaneously. Reading operations hold the stream until the
+// result is closed.
+func (x *Limits) SetMaxConcurrentStreams(n int) {
+	x.maxStreams = n
+}
+
+// SetRequestRate specifies maximum number of the requests of the given class
+// per second. Requests can be sent in bursts of the rate size.
+func (x *Limits) SetRequestRate(class OperationClass, rate float64) {
+	if class < operationClassLast {
+		x.requestRate[class] = rate
+	}
+}
+
+// SetPayloadRate specifies maximum number of the object payload bytes
+// written and read per second.
+func (x *Limits) SetPayloadRate(bytesPerSecond float64) {
+	x.payloadRate = bytesPerSecond
+}
+
+// tokenBucket implements token bucket algorithm.
+type tokenBucket struct {
+	mtx sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(rate, 1)
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// take takes n tokens. If the bucket doesn't have enough tokens and wait is
+// false, nothing is taken and false is returned. Otherwise, tokens are taken
+// in debt and the time to wait until the debt is covered is returned. Requests
+// bigger than the burst are allowed if the bucket is full.
+func (x *tokenBucket) take(n float64, wait bool, now time.Time) (time.Duration, bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if elapsed := now.Sub(x.last); elapsed > 0 {
+		x.tokens = math.Min(x.burst, x.tokens+elapsed.Seconds()*x.rate)
+		x.last = now
+	}
+
+	if x.tokens >= math.Min(n, x.burst) {
+		x.tokens -= n
+		return 0, true
+	}
+
+	if !wait {
+		return 0, false
+	}
+
+	x.tokens -= n
+
+	return time.Duration(-x.tokens / x.rate * float64(time.Second)), true
+}
+
+// refund returns tokens taken in debt.
+func (x *tokenBucket) refund(n float64) {
+	x.mtx.Lock()
+	x.tokens = math.Min(x.burst, x.tokens+n)
+	x.mtx.Unlock()
+}
+
+// wait takes n tokens from the bucket and waits until they are available.
+func (x *tokenBucket) wait(ctx context.Context, n float64, failFast bool) error {
+	d, ok := x.take(n, !failFast, time.Now())
+	if !ok {
+		return ErrLimitExceeded
+	}
+
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		x.refund(n)
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// limiter enforces Limits.
+type limiter struct {
+	streams  chan struct{}
+	requests [operationClassLast]*tokenBucket
+	payload  *tokenBucket
+}
+
+// newLimiter returns limiter enforcing the given limits. Returns nil if there
+// are no limits.
+func newLimiter(l Limits) *limiter {
+	var res limiter
+	limited := false
+
+	if l.maxStreams > 0 {
+		res.streams = make(chan struct{}, l.maxStreams)
+		limited = true
+	}
+
+	for i := range l.requestRate {
+		if l.requestRate[i] > 0 {
+			res.requests[i] = newTokenBucket(l.requestRate[i])
+			limited = true
+		}
+	}
+
+	if l.payloadRate > 0 {
+		res.payload = newTokenBucket(l.payloadRate)
+		limited = true
+	}
+
+	if !limited {
+		return nil
+	}
+
+	return &res
+}
+
+func (x *limiter) acquireStream(ctx context.Context, failFast bool) error {
+	if x == nil || x.streams == nil {
+		return nil
+	}
+
+	if failFast {
+		select {
+		case x.streams <- struct{}{}:
+			return nil
+		default:
+			return ErrLimitExceeded
+		}
+	}
+
+	select {
+	case x.streams <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (x *limiter) releaseStream() {
+	if x != nil && x.streams != nil {
+		<-x.streams
+	}
+}
+
+func (x *limiter) waitRequest(ctx context.Context, class OperationClass, failFast bool) error {
+	if x == nil || x.requests[class] == nil {
+		return nil
+	}
+
+	return x.requests[class].wait(ctx, 1, failFast)
+}
+
+func (x *limiter) waitPayload(ctx context.Context, n int, failFast bool) error {
+	if x == nil || x.payload == nil || n <= 0 {
+		return nil
+	}
+
+	return x.payload.wait(ctx, float64(n), failFast)
+}
+
+// limiters returns limiters applied to the requests to the node with the
+// given address.
+func (p *Pool) limiters(address string) []*limiter {
+	var res []*limiter
+
+	if p.limiter != nil {
+		res = append(res, p.limiter)
+	}
+
+	if _, cp := p.findNode(address); cp != nil && cp.limiter != nil {
+		res = append(res, cp.limiter)
+	}
+
+	return res
+}
+
+// limitRequest waits until the request to the node fits the limits. Returns
+// function which must be called after the request stream is finished, nil
+// function means that there are no limits.
+func (p *Pool) limitRequest(ctx context.Context, op Operation, address string) (func(), error) {
+	ls := p.limiters(address)
+	if len(ls) == 0 {
+		return nil, nil
+	}
+
+	var acquired []*limiter
+
+	release := func() {
+		for i := range acquired {
+			acquired[i].releaseStream()
+		}
+	}
+
+	for i := range ls {
+		if err := ls[i].acquireStream(ctx, p.failFastLimits); err != nil {
+			release()
+			return nil, err
+		}
+
+		acquired = append(acquired, ls[i])
+	}
+
+	if class, ok := operationClass(op); ok {
+		for i := range ls {
+			if err := ls[i].waitRequest(ctx, class, p.failFastLimits); err != nil {
+				release()
+				return nil, err
+			}
+		}
+	}
+
+	var once sync.Once
+
+	return func() { once.Do(release) }, nil
+}
+
+// limitPayload waits until the payload of n bytes transmitted to or from the
+// node fits the limits.
+func (p *Pool) limitPayload(ctx context.Context, address string, n int) error {
+	for _, l := range p.limiters(address) {
+		if err := l.waitPayload(ctx, n, p.failFastLimits); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// limitedReader applies payload limits to the object payload stream.
+type limitedReader struct {
+	r io.Reader
+	// nil if there are no limits
+	limit func(n int) error
+}
+
+func (x *limitedReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	if n > 0 && x.limit != nil {
+		if errLimit := x.limit(n); errLimit != nil {
+			return n, errLimit
+		}
+	}
+
+	return n, err
+}
+
+// limitedPayload applies payload limits to the read object payload and
+// releases the stream on Close.
+type limitedPayload struct {
+	limitedReader
+	c       io.Closer
+	release func()
+}
+
+func (x *limitedPayload) Close() error {
+	err := x.c.Close()
+	x.release()
+	return err
+}
+
+// newLimitedPayload returns payload reader with limits of the call context
+// which closes the result stream of the call on Close.
+func (p *Pool) newLimitedPayload(ctx *callContext, payload io.ReadCloser) io.ReadCloser {
+	if ctx.release == nil {
+		return payload
+	}
+
+	if payload == nil {
+		ctx.release()
+		return nil
+	}
+
+	res := &limitedPayload{
+		limitedReader: limitedReader{r: payload},
+		c:             payload,
+		release:       ctx.release,
+	}
+
+	if p.limited {
+		address := ctx.endpoint
+		res.limit = func(n int) error {
+			return p.limitPayload(ctx, address, n)
+		}
+	}
+
+	return res
+}
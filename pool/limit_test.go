@@ -0,0 +1,147 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+
+	b := newTokenBucket(10)
+	b.last = now
+
+	for i := 0; i < 10; i++ {
+		d, ok := b.take(1, false, now)
+		require.True(t, ok)
+		require.Zero(t, d)
+	}
+
+	_, ok := b.take(1, false, now)
+	require.False(t, ok)
+
+	d, ok := b.take(1, true, now)
+	require.True(t, ok)
+	require.Equal(t, 100*time.Millisecond, d)
+
+	// debt is covered
+	_, ok = b.take(1, false, now.Add(200*time.Millisecond))
+	require.True(t, ok)
+
+	// request bigger than burst is allowed to full bucket
+	_, ok = b.take(100, false, now.Add(time.Hour))
+	require.True(t, ok)
+	_, ok = b.take(1, false, now.Add(time.Hour))
+	require.False(t, ok)
+}
+
+func TestPoolLimitRequest(t *testing.T) {
+	var l Limits
+	l.SetMaxConcurrentStreams(1)
+	l.SetRequestRate(OperationClassPut, 1)
+
+	p := &Pool{limiter: newLimiter(l), failFastLimits: true}
+
+	release, err := p.limitRequest(context.Background(), OperationObjectHead, "any")
+	require.NoError(t, err)
+
+	_, err = p.limitRequest(context.Background(), OperationObjectHead, "any")
+	require.ErrorIs(t, err, ErrLimitExceeded)
+	require.False(t, isNodeFailure(fmt.Errorf("wrapped: %w", err)))
+
+	release()
+	release() // must be idempotent
+
+	release, err = p.limitRequest(context.Background(), OperationObjectPut, "any")
+	require.NoError(t, err)
+	release()
+
+	_, err = p.limitRequest(context.Background(), OperationObjectPut, "any")
+	require.ErrorIs(t, err, ErrLimitExceeded)
+
+	t.Run("blocking", func(t *testing.T) {
+		p.failFastLimits = false
+
+		release, err := p.limitRequest(context.Background(), OperationObjectDelete, "any")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = p.limitRequest(ctx, OperationObjectDelete, "any")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		release()
+
+		release, err = p.limitRequest(context.Background(), OperationObjectDelete, "any")
+		require.NoError(t, err)
+		release()
+	})
+
+	p = new(Pool)
+	release, err = p.limitRequest(context.Background(), OperationObjectPut, "any")
+	require.NoError(t, err)
+	require.Nil(t, release)
+}
+
+func TestPoolPayloadLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	node := newSessionMock(t, ctrl)
+	node.EXPECT().objectPut(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectPut) (*oid.ID, error) {
+		if _, err := io.ReadAll(prm.payload); err != nil {
+			return nil, partialPutError{err}
+		}
+
+		return new(oid.ID), nil
+	}).Times(2)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	var l Limits
+	l.SetPayloadRate(10)
+
+	p := &Pool{
+		innerPools: []*innerPool{{
+			sampler: newSampler([]float64{1}, rand.NewSource(0)),
+			clientPacks: []*clientPack{
+				{client: node, healthy: true, address: "node", limiter: newLimiter(l)},
+			},
+		}},
+		cache:          cache,
+		signer:         neofscryptotest.Signer(),
+		failFastLimits: true,
+		limited:        true,
+	}
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+
+	newPrm := func() PrmObjectPut {
+		var prm PrmObjectPut
+		prm.SetHeader(*hdr)
+		prm.SetPayload(bytes.NewReader(make([]byte, 100)))
+
+		return prm
+	}
+
+	// full bucket allows the payload bigger than the rate
+	_, err = p.PutObject(context.Background(), newPrm())
+	require.NoError(t, err)
+
+	_, err = p.PutObject(context.Background(), newPrm())
+	require.ErrorIs(t, err, ErrLimitExceeded)
+}
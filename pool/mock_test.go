@@ -56,6 +56,20 @@ func (mr *MockClientMockRecorder) balanceGet(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "balanceGet", reflect.TypeOf((*MockClient)(nil).balanceGet), arg0, arg1)
 }
 
+// close mocks base method.
+func (m *MockClient) close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// close indicates an expected call of close.
+func (mr *MockClientMockRecorder) close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "close", reflect.TypeOf((*MockClient)(nil).close))
+}
+
 // containerDelete mocks base method.
 func (m *MockClient) containerDelete(arg0 context.Context, arg1 PrmContainerDelete) error {
 	m.ctrl.T.Helper()
@@ -277,3 +291,17 @@ func (mr *MockClientMockRecorder) sessionCreate(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "sessionCreate", reflect.TypeOf((*MockClient)(nil).sessionCreate), arg0, arg1)
 }
+
+// statistic mocks base method.
+func (m *MockClient) statistic() *nodeStat {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "statistic")
+	ret0, _ := ret[0].(*nodeStat)
+	return ret0
+}
+
+// statistic indicates an expected call of statistic.
+func (mr *MockClientMockRecorder) statistic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "statistic", reflect.TypeOf((*MockClient)(nil).statistic))
+}
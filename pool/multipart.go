@@ -0,0 +1,572 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+	"go.uber.org/zap"
+)
+
+// MultipartPart describes the uploaded part of the object.
+type MultipartPart struct {
+	number uint32
+	id     oid.ID
+	size   uint64
+
+	homoHashSet bool
+	homoHash    checksum.Checksum
+}
+
+// Number returns sequence number of the part within the upload.
+func (x MultipartPart) Number() uint32 {
+	return x.number
+}
+
+// ID returns identifier of the child object which stores the part.
+func (x MultipartPart) ID() oid.ID {
+	return x.id
+}
+
+// Size returns size of the part payload in bytes.
+func (x MultipartPart) Size() uint64 {
+	return x.size
+}
+
+// HomomorphicHash returns homomorphic hash of the part payload calculated by
+// the storage node. Returns false if the container doesn't require homomorphic
+// hashing.
+func (x MultipartPart) HomomorphicHash() (checksum.Checksum, bool) {
+	return x.homoHash, x.homoHashSet
+}
+
+// MultipartUpload represents state of the object upload by parts. Each part
+// is stored as a separate child object of the resulting object, parts can be
+// uploaded in parallel and in any order. Upload state can be saved using
+// MarshalJSON and restored using UnmarshalJSON to resume the upload after
+// the process restart.
+//
+// MultipartUpload is safe for concurrent use. Instances must be created via
+// Pool.InitMultipartUpload or restored using UnmarshalJSON.
+//
+// See also Pool.PutPart, Pool.CompleteMultipartUpload, Pool.AbortMultipartUpload.
+type MultipartUpload struct {
+	mtx sync.Mutex
+
+	hdr     object.Object
+	splitID *object.SplitID
+	parts   map[uint32]MultipartPart
+}
+
+// Header returns header template of the resulting object.
+func (x *MultipartUpload) Header() object.Object {
+	return x.hdr
+}
+
+// Parts returns the uploaded parts sorted by number.
+func (x *MultipartUpload) Parts() []MultipartPart {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	res := make([]MultipartPart, 0, len(x.parts))
+	for _, part := range x.parts {
+		res = append(res, part)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].number < res[j].number
+	})
+
+	return res
+}
+
+func (x *MultipartUpload) addPart(part MultipartPart) {
+	x.mtx.Lock()
+	x.parts[part.number] = part
+	x.mtx.Unlock()
+}
+
+func (x *MultipartUpload) removePart(number uint32) {
+	x.mtx.Lock()
+	delete(x.parts, number)
+	x.mtx.Unlock()
+}
+
+type multipartPartJSON struct {
+	Number          uint32 `json:"number"`
+	ID              string `json:"id"`
+	Size            uint64 `json:"size"`
+	HomomorphicHash string `json:"homomorphicHash,omitempty"`
+}
+
+type multipartUploadJSON struct {
+	Header  json.RawMessage     `json:"header"`
+	SplitID string              `json:"splitID"`
+	Parts   []multipartPartJSON `json:"parts"`
+}
+
+// MarshalJSON encodes the upload state into JSON format.
+//
+// See also UnmarshalJSON.
+func (x *MultipartUpload) MarshalJSON() ([]byte, error) {
+	hdr, err := x.hdr.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("encode header: %w", err)
+	}
+
+	parts := x.Parts()
+
+	res := multipartUploadJSON{
+		Header:  hdr,
+		SplitID: x.splitID.String(),
+		Parts:   make([]multipartPartJSON, len(parts)),
+	}
+
+	for i := range parts {
+		res.Parts[i] = multipartPartJSON{
+			Number: parts[i].number,
+			ID:     parts[i].id.EncodeToString(),
+			Size:   parts[i].size,
+		}
+
+		if parts[i].homoHashSet {
+			res.Parts[i].HomomorphicHash = hex.EncodeToString(parts[i].homoHash.Value())
+		}
+	}
+
+	return json.Marshal(res)
+}
+
+// UnmarshalJSON decodes the upload state from JSON format.
+//
+// See also MarshalJSON.
+func (x *MultipartUpload) UnmarshalJSON(data []byte) error {
+	var v multipartUploadJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	// header of the upload has no ID, so it is decoded without the format check
+	var hdrV2 v2object.Object
+	if err := hdrV2.UnmarshalJSON(v.Header); err != nil {
+		return fmt.Errorf("decode header: %w", err)
+	}
+
+	hdr := *object.NewFromV2(&hdrV2)
+
+	splitID := object.NewSplitID()
+	if err := splitID.Parse(v.SplitID); err != nil {
+		return fmt.Errorf("decode split ID: %w", err)
+	}
+
+	parts := make(map[uint32]MultipartPart, len(v.Parts))
+
+	for i := range v.Parts {
+		part := MultipartPart{
+			number: v.Parts[i].Number,
+			size:   v.Parts[i].Size,
+		}
+
+		if err := part.id.DecodeString(v.Parts[i].ID); err != nil {
+			return fmt.Errorf("decode ID of the part #%d: %w", part.number, err)
+		}
+
+		if v.Parts[i].HomomorphicHash != "" {
+			b, err := hex.DecodeString(v.Parts[i].HomomorphicHash)
+			if err != nil {
+				return fmt.Errorf("decode homomorphic hash of the part #%d: %w", part.number, err)
+			}
+
+			if len(b) != tz.Size {
+				return fmt.Errorf("decode homomorphic hash of the part #%d: wrong length %d", part.number, len(b))
+			}
+
+			var hash [tz.Size]byte
+			copy(hash[:], b)
+
+			part.homoHash.SetTillichZemor(hash)
+			part.homoHashSet = true
+		}
+
+		parts[part.number] = part
+	}
+
+	x.mtx.Lock()
+	x.hdr = hdr
+	x.splitID = splitID
+	x.parts = parts
+	x.mtx.Unlock()
+
+	return nil
+}
+
+// InitMultipartUpload starts new upload of the object by parts. The header is
+// a template of the resulting object: its container, owner, type and attributes
+// are inherited. If the owner is not set, the Pool's signer is the owner.
+// Container is required.
+//
+// No requests are sent to the network until the parts are uploaded.
+func (p *Pool) InitMultipartUpload(hdr object.Object) (*MultipartUpload, error) {
+	if _, ok := hdr.ContainerID(); !ok {
+		return nil, errors.New("missing container in the header")
+	}
+
+	if id := hdr.OwnerID(); id == nil || id.Equals(user.ID{}) {
+		var owner user.ID
+
+		if err := user.IDFromSigner(&owner, p.signer); err != nil {
+			return nil, fmt.Errorf("owner from the signer: %w", err)
+		}
+
+		// header is shared with the caller
+		hdrV2 := *hdr.ToV2()
+		if h := hdrV2.GetHeader(); h != nil {
+			hCopy := *h
+			hdrV2.SetHeader(&hCopy)
+		}
+
+		hdr = *object.NewFromV2(&hdrV2)
+		hdr.SetOwnerID(&owner)
+	}
+
+	return &MultipartUpload{
+		hdr:     hdr,
+		splitID: object.NewSplitID(),
+		parts:   make(map[uint32]MultipartPart),
+	}, nil
+}
+
+// baseHeader returns new object header with the container and the owner of
+// the upload.
+func (x *MultipartUpload) baseHeader() *object.Object {
+	cnr, _ := x.hdr.ContainerID()
+
+	obj := object.New()
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(x.hdr.OwnerID())
+
+	return obj
+}
+
+// childHeader returns header of the child object of the upload.
+func (x *MultipartUpload) childHeader() *object.Object {
+	obj := x.baseHeader()
+	obj.SetSplitID(x.splitID)
+
+	return obj
+}
+
+// partAddress returns address of the child object which stores the part.
+func (x *MultipartUpload) partAddress(part MultipartPart) oid.Address {
+	cnr, _ := x.hdr.ContainerID()
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(part.id)
+
+	return addr
+}
+
+// PrmPartPut groups parameters of PutPart operation.
+type PrmPartPut struct {
+	prmCommon
+
+	number  uint32
+	payload io.Reader
+}
+
+// SetNumber specifies sequence number of the part. Parts are concatenated
+// in ascending order of their numbers, numbers may be sparse.
+func (x *PrmPartPut) SetNumber(number uint32) {
+	x.number = number
+}
+
+// SetPayload specifies payload of the part. Payload must not exceed the
+// maximum object size of the network.
+func (x *PrmPartPut) SetPayload(payload io.Reader) {
+	x.payload = payload
+}
+
+// countingReader counts bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n uint64
+}
+
+func (x *countingReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	x.n += uint64(n)
+	return n, err
+}
+
+// PutPart stores the part of the upload as a child object. The part with
+// already uploaded number is replaced, the previous child object is left
+// as is. Parts may be uploaded concurrently. Header of the stored part is read
+// back to get the homomorphic hash of its payload.
+//
+// Returns the description of the uploaded part which is also registered in
+// the upload.
+func (p *Pool) PutPart(ctx context.Context, upload *MultipartUpload, prm PrmPartPut) (*MultipartPart, error) {
+	payload := &countingReader{r: prm.payload}
+	if prm.payload == nil {
+		payload.r = eofReader{}
+	}
+
+	var prmPut PrmObjectPut
+	prmPut.prmCommon = prm.prmCommon
+	prmPut.SetHeader(*upload.childHeader())
+	prmPut.SetPayload(payload)
+
+	id, err := p.PutObject(ctx, prmPut)
+	if err != nil {
+		return nil, fmt.Errorf("store part #%d: %w", prm.number, err)
+	}
+
+	part := MultipartPart{
+		number: prm.number,
+		id:     *id,
+		size:   payload.n,
+	}
+
+	var prmHead PrmObjectHead
+	prmHead.prmCommon = prm.prmCommon
+	prmHead.SetAddress(upload.partAddress(part))
+
+	hdr, err := p.HeadObject(ctx, prmHead)
+	if err != nil {
+		return nil, fmt.Errorf("read header of the part #%d: %w", prm.number, err)
+	}
+
+	part.homoHash, part.homoHashSet = hdr.PayloadHomomorphicHash()
+
+	upload.addPart(part)
+
+	return &part, nil
+}
+
+// eofReader is an empty payload.
+type eofReader struct{}
+
+func (eofReader) Read([]byte) (int, error) {
+	return 0, io.EOF
+}
+
+// PrmMultipartComplete groups parameters of CompleteMultipartUpload operation.
+type PrmMultipartComplete struct {
+	prmCommon
+
+	checksumSet bool
+	checksum    checksum.Checksum
+}
+
+// SetPayloadChecksum specifies SHA-256 checksum of the full payload of the
+// resulting object. If not set, the uploaded parts are read back to calculate
+// it.
+func (x *PrmMultipartComplete) SetPayloadChecksum(cs checksum.Checksum) {
+	x.checksum = cs
+	x.checksumSet = true
+}
+
+// CompleteMultipartUpload finishes the upload: it binds the uploaded parts in
+// the order of their numbers into the resulting object. Since the parts are
+// uploaded independently, the last part is read back and stored again as the
+// child which refers to the previous part and carries the resulting object
+// header, the originally uploaded last part is removed. After that, the
+// linking object which lists all parts is written.
+//
+// Header of the resulting object is signed with the signer of the operation
+// (see PrmMultipartComplete.UseSigner), which must correspond to the object
+// owner. Homomorphic hash of the resulting payload is concatenated from the
+// ones of the parts, if any. Returns identifier of the resulting object.
+func (p *Pool) CompleteMultipartUpload(ctx context.Context, upload *MultipartUpload, prm PrmMultipartComplete) (*oid.ID, error) {
+	parts := upload.Parts()
+	if len(parts) == 0 {
+		return nil, errors.New("no uploaded parts")
+	}
+
+	p.fillAppropriateKey(&prm.prmCommon)
+
+	var (
+		size       uint64
+		children   = make([]oid.ID, len(parts))
+		homoHashes = make([]checksum.Checksum, 0, len(parts))
+	)
+
+	for i := range parts {
+		size += parts[i].size
+		children[i] = parts[i].id
+
+		if parts[i].homoHashSet {
+			homoHashes = append(homoHashes, parts[i].homoHash)
+		}
+	}
+
+	if len(homoHashes) > 0 && len(homoHashes) != len(parts) {
+		return nil, errors.New("homomorphic hash is missing in some parts")
+	}
+
+	last := parts[len(parts)-1]
+
+	var lastPayload bytes.Buffer
+
+	if err := p.readPart(ctx, upload, last, prm.prmCommon, &lastPayload); err != nil {
+		return nil, err
+	}
+
+	cs := prm.checksum
+	if !prm.checksumSet {
+		h := checksum.NewHasher(checksum.SHA256)
+
+		for i := range parts[:len(parts)-1] {
+			if err := p.readPart(ctx, upload, parts[i], prm.prmCommon, h); err != nil {
+				return nil, err
+			}
+		}
+
+		_, _ = h.Write(lastPayload.Bytes())
+
+		cs = h.Checksum()
+	}
+
+	parent := upload.baseHeader()
+	parent.SetType(upload.hdr.Type())
+	parent.SetAttributes(upload.hdr.Attributes()...)
+	parent.SetCreationEpoch(upload.hdr.CreationEpoch())
+	parent.SetPayloadSize(size)
+	parent.SetPayloadChecksum(cs)
+
+	if len(homoHashes) > 0 {
+		var csHomo checksum.Checksum
+
+		if err := checksum.ConcatTZ(&csHomo, homoHashes...); err != nil {
+			return nil, fmt.Errorf("calculate homomorphic hash: %w", err)
+		}
+
+		parent.SetPayloadHomomorphicHash(csHomo)
+	}
+
+	if upload.hdr.ToV2().GetHeader().GetVersion() != nil {
+		parent.SetVersion(upload.hdr.Version())
+	} else {
+		ver := version.Current()
+		parent.SetVersion(&ver)
+	}
+
+	if err := object.SetIDWithSigner(prm.signer, parent); err != nil {
+		return nil, fmt.Errorf("finalize parent header: %w", err)
+	}
+
+	tail := upload.childHeader()
+	tail.SetParent(parent)
+
+	if len(parts) > 1 {
+		tail.SetPreviousID(parts[len(parts)-2].id)
+	}
+
+	var prmPut PrmObjectPut
+	prmPut.prmCommon = prm.prmCommon
+	prmPut.SetHeader(*tail)
+	prmPut.SetPayload(&lastPayload)
+
+	tailID, err := p.PutObject(ctx, prmPut)
+	if err != nil {
+		return nil, fmt.Errorf("store last part #%d: %w", last.number, err)
+	}
+
+	children[len(children)-1] = *tailID
+
+	link := upload.childHeader()
+	link.SetParent(parent)
+	link.SetChildren(children...)
+
+	var prmLink PrmObjectPut
+	prmLink.prmCommon = prm.prmCommon
+	prmLink.SetHeader(*link)
+
+	if _, err = p.PutObject(ctx, prmLink); err != nil {
+		return nil, fmt.Errorf("store linking object: %w", err)
+	}
+
+	stored := last
+	stored.id = *tailID
+
+	upload.addPart(stored)
+
+	var prmDelete PrmObjectDelete
+	prmDelete.prmCommon = prm.prmCommon
+	prmDelete.SetAddress(upload.partAddress(last))
+
+	if err = p.DeleteObject(ctx, prmDelete); err != nil && p.logger != nil {
+		p.logger.Warn("failed to remove the replaced last part of the multipart upload",
+			zap.Stringer("address", prmDelete.addr),
+			zap.Error(err))
+	}
+
+	id, _ := parent.ID()
+
+	return &id, nil
+}
+
+// readPart reads payload of the uploaded part into w.
+func (p *Pool) readPart(ctx context.Context, upload *MultipartUpload, part MultipartPart, prm prmCommon, w io.Writer) error {
+	var prmGet PrmObjectGet
+	prmGet.prmCommon = prm
+	prmGet.SetAddress(upload.partAddress(part))
+
+	res, err := p.GetObject(ctx, prmGet)
+	if err != nil {
+		return fmt.Errorf("read part #%d: %w", part.number, err)
+	}
+
+	n, err := io.Copy(w, res.Payload)
+	_ = res.Payload.Close()
+
+	if err == nil && uint64(n) != part.size {
+		err = fmt.Errorf("wrong payload size %d instead of %d", n, part.size)
+	}
+
+	if err != nil {
+		return fmt.Errorf("read payload of the part #%d: %w", part.number, err)
+	}
+
+	return nil
+}
+
+// PrmMultipartAbort groups parameters of AbortMultipartUpload operation.
+type PrmMultipartAbort struct {
+	prmCommon
+}
+
+// AbortMultipartUpload cancels the upload and marks all uploaded parts for
+// deletion. Removed parts are excluded from the upload, so the operation can
+// be repeated after the failure.
+func (p *Pool) AbortMultipartUpload(ctx context.Context, upload *MultipartUpload, prm PrmMultipartAbort) error {
+	var prmDelete PrmObjectDelete
+	prmDelete.prmCommon = prm.prmCommon
+
+	for _, part := range upload.Parts() {
+		prmDelete.SetAddress(upload.partAddress(part))
+
+		if err := p.DeleteObject(ctx, prmDelete); err != nil {
+			return fmt.Errorf("remove part #%d: %w", part.number, err)
+		}
+
+		upload.removePart(part.number)
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/assembler"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+// partsSource is an assembler.ObjectSource of the stored objects.
+type partsSource map[oid.ID]object.Object
+
+func (x partsSource) get(addr oid.Address) (object.Object, error) {
+	obj, ok := x[addr.Object()]
+	if !ok {
+		return object.Object{}, apistatus.ObjectNotFound{}
+	}
+
+	return obj, nil
+}
+
+func (x partsSource) Head(_ context.Context, addr oid.Address) (*object.Object, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.CutPayload(), nil
+}
+
+func (x partsSource) Payload(_ context.Context, addr oid.Address) (io.ReadCloser, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(obj.Payload())), nil
+}
+
+func (x partsSource) Range(_ context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(obj.Payload()[off : off+ln])), nil
+}
+
+func (x partsSource) Search(context.Context, cid.ID, object.SearchFilters) ([]oid.ID, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestPoolMultipartUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	p := &Pool{
+		cache:  cache,
+		signer: neofscryptotest.Signer(),
+	}
+
+	var owner user.ID
+	require.NoError(t, user.IDFromSigner(&owner, p.signer))
+
+	stored := make(map[oid.ID]object.Object)
+	deleted := make(map[string]struct{})
+
+	var link *object.Object
+	var linkID oid.ID
+
+	node := newSessionMock(t, ctrl)
+	node.EXPECT().objectPut(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectPut) (*oid.ID, error) {
+		require.True(t, owner.Equals(*prm.hdr.OwnerID()))
+		require.NotNil(t, prm.hdr.SplitID())
+
+		var payload []byte
+		if prm.payload != nil {
+			var err error
+			payload, err = io.ReadAll(prm.payload)
+			require.NoError(t, err)
+		}
+
+		// finalize the object like the storage node does within the session
+		data, err := prm.hdr.Marshal()
+		require.NoError(t, err)
+
+		// header has no ID yet, so decode without the format check
+		var objV2 v2object.Object
+		require.NoError(t, objV2.Unmarshal(data))
+
+		obj := *object.NewFromV2(&objV2)
+
+		var cs checksum.Checksum
+
+		obj.SetPayload(payload)
+		obj.SetPayloadSize(uint64(len(payload)))
+		checksum.Calculate(&cs, checksum.SHA256, payload)
+		obj.SetPayloadChecksum(cs)
+		checksum.Calculate(&cs, checksum.TZ, payload)
+		obj.SetPayloadHomomorphicHash(cs)
+		require.NoError(t, object.SetIDWithSigner(p.signer, &obj))
+
+		id, _ := obj.ID()
+		stored[id] = obj
+
+		if len(obj.Children()) > 0 {
+			link, linkID = obj.CutPayload(), id
+		}
+
+		return &id, nil
+	}).AnyTimes()
+	node.EXPECT().objectHead(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectHead) (*object.Object, error) {
+		obj, ok := stored[prm.addr.Object()]
+		require.True(t, ok)
+
+		return obj.CutPayload(), nil
+	}).AnyTimes()
+	node.EXPECT().objectGet(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectGet) (*ResGetObject, error) {
+		obj, ok := stored[prm.addr.Object()]
+		require.True(t, ok)
+
+		return &ResGetObject{
+			Header:  *obj.CutPayload(),
+			Payload: io.NopCloser(bytes.NewReader(obj.Payload())),
+		}, nil
+	}).AnyTimes()
+	node.EXPECT().objectDelete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectDelete) error {
+		deleted[prm.addr.Object().EncodeToString()] = struct{}{}
+		return nil
+	}).AnyTimes()
+
+	p.innerPools = []*innerPool{{
+		sampler:     newSampler([]float64{1}, rand.NewSource(0)),
+		clientPacks: []*clientPack{{client: node, healthy: true, address: "node"}},
+	}}
+
+	_, err = p.InitMultipartUpload(*object.New())
+	require.Error(t, err)
+
+	attr := object.NewAttribute()
+	attr.SetKey("FileName")
+	attr.SetValue("big.bin")
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetAttributes(*attr)
+
+	upload, err := p.InitMultipartUpload(*hdr)
+	require.NoError(t, err)
+	// header of the caller is not changed
+	require.True(t, hdr.OwnerID().Equals(user.ID{}))
+
+	payloads := [][]byte{[]byte("first part"), []byte("second part")}
+
+	putPart := func(upload *MultipartUpload, number uint32) {
+		var prm PrmPartPut
+		prm.SetNumber(number)
+		prm.SetPayload(bytes.NewReader(payloads[number-1]))
+
+		part, err := p.PutPart(context.Background(), upload, prm)
+		require.NoError(t, err)
+		require.Equal(t, number, part.Number())
+		require.EqualValues(t, len(payloads[number-1]), part.Size())
+
+		var cs checksum.Checksum
+		checksum.Calculate(&cs, checksum.TZ, payloads[number-1])
+
+		homoHash, ok := part.HomomorphicHash()
+		require.True(t, ok)
+		require.Equal(t, cs, homoHash)
+	}
+
+	putPart(upload, 2)
+
+	// resume the upload after restart
+	data, err := upload.MarshalJSON()
+	require.NoError(t, err)
+
+	var restored MultipartUpload
+	require.NoError(t, restored.UnmarshalJSON(data))
+	require.Equal(t, upload.Parts(), restored.Parts())
+	require.Equal(t, upload.splitID.String(), restored.splitID.String())
+
+	putPart(&restored, 1)
+
+	parts := restored.Parts()
+	require.Len(t, parts, 2)
+	require.EqualValues(t, 1, parts[0].Number())
+	require.EqualValues(t, 2, parts[1].Number())
+
+	id, err := p.CompleteMultipartUpload(context.Background(), &restored, PrmMultipartComplete{})
+	require.NoError(t, err)
+
+	// last part is stored again with the reference to the previous one
+	completed := restored.Parts()
+	require.Len(t, completed, 2)
+	require.Equal(t, parts[0], completed[0])
+	require.NotEqual(t, parts[1].ID(), completed[1].ID())
+	require.Contains(t, deleted, parts[1].ID().EncodeToString())
+
+	tail := stored[completed[1].ID()]
+	prev, ok := tail.PreviousID()
+	require.True(t, ok)
+	require.Equal(t, parts[0].ID(), prev)
+	require.Equal(t, payloads[1], tail.Payload())
+	require.Equal(t, restored.splitID.String(), tail.SplitID().String())
+
+	require.NotNil(t, link)
+	require.Equal(t, []oid.ID{parts[0].ID(), completed[1].ID()}, link.Children())
+	require.Equal(t, restored.splitID.String(), link.SplitID().String())
+
+	parent := link.Parent()
+	require.NotNil(t, parent)
+	require.NoError(t, object.CheckHeaderVerificationFields(parent))
+
+	parentID, ok := parent.ID()
+	require.True(t, ok)
+	require.Equal(t, parentID, *id)
+	require.Nil(t, parent.SplitID())
+	require.Equal(t, hdr.Attributes(), parent.Attributes())
+	require.EqualValues(t, len(payloads[0])+len(payloads[1]), parent.PayloadSize())
+
+	cs, ok := parent.PayloadChecksum()
+	require.True(t, ok)
+	require.Equal(t, checksum.SHA256, cs.Type())
+
+	full := append(append([]byte(nil), payloads[0]...), payloads[1]...)
+
+	expected := sha256.Sum256(full)
+	require.Equal(t, expected[:], cs.Value())
+
+	var csHomo checksum.Checksum
+	checksum.Calculate(&csHomo, checksum.TZ, full)
+
+	homoHash, ok := parent.PayloadHomomorphicHash()
+	require.True(t, ok)
+	require.Equal(t, csHomo, homoHash)
+
+	require.Equal(t, parent, tail.Parent())
+
+	// resulting object is assembled from the stored children
+	cnr, _ := hdr.ContainerID()
+	a := assembler.New(partsSource(stored))
+
+	var siLink object.SplitInfo
+	siLink.SetLink(linkID)
+
+	var siLast object.SplitInfo
+	siLast.SetLastPart(completed[1].ID())
+
+	for _, si := range []object.SplitInfo{siLink, siLast} {
+		r, err := a.Assemble(context.Background(), cnr, si)
+		require.NoError(t, err)
+
+		assembled, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, full, assembled)
+
+		assembledHdr := r.Header()
+		assembledID, ok := assembledHdr.ID()
+		require.True(t, ok)
+		require.Equal(t, *id, assembledID)
+	}
+
+	require.NoError(t, p.AbortMultipartUpload(context.Background(), &restored, PrmMultipartAbort{}))
+	require.Empty(t, restored.Parts())
+	require.Contains(t, deleted, parts[0].ID().EncodeToString())
+	require.Contains(t, deleted, completed[1].ID().EncodeToString())
+
+	_, err = p.CompleteMultipartUpload(context.Background(), &restored, PrmMultipartComplete{})
+	require.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+)
+
+// AddNode dials the node and adds it to the Pool. The node is added to the
+// group with the given priority, a new group is created if needed. Sampler
+// weights of the group are recalculated.
+//
+// Unlike the other node management methods, AddNode takes the context: it is
+// used to dial the new node, so the caller may limit the time spent on the
+// connection establishment in addition to the dial timeout of the Pool.
+//
+// Note that the set of nodes is replaced on each epoch if node discovery is
+// enabled, so the node added manually may be removed by the discovery.
+func (p *Pool) AddNode(ctx context.Context, prm NodeParam) error {
+	p.updateLock.Lock()
+	defer p.updateLock.Unlock()
+
+	p.lock.RLock()
+	nodeParams := make([]NodeParam, 0, len(p.nodeParams)+1)
+	nodeParams = append(nodeParams, p.nodeParams...)
+	p.lock.RUnlock()
+
+	for i := range nodeParams {
+		if nodeParams[i].address == prm.address {
+			return fmt.Errorf("node %s is already in the pool", prm.address)
+		}
+	}
+
+	return p.setNodes(ctx, append(nodeParams, prm))
+}
+
+// RemoveNode removes the node with the given address from the Pool and
+// closes connection to it. Requests which are being executed on the node may
+// fail, use DrainNode to let them finish.
+//
+// Note that the set of nodes is replaced on each epoch if node discovery is
+// enabled, so the removed node may be added back by the discovery.
+func (p *Pool) RemoveNode(address string) error {
+	p.updateLock.Lock()
+	defer p.updateLock.Unlock()
+
+	p.lock.RLock()
+	nodeParams := make([]NodeParam, 0, len(p.nodeParams))
+	for i := range p.nodeParams {
+		if p.nodeParams[i].address != address {
+			nodeParams = append(nodeParams, p.nodeParams[i])
+		}
+	}
+	found := len(nodeParams) != len(p.nodeParams)
+	p.lock.RUnlock()
+
+	if !found {
+		return fmt.Errorf("node %s not found", address)
+	}
+
+	// no nodes are dialed on removal, so context isn't used
+	return p.setNodes(context.Background(), nodeParams)
+}
+
+// DrainNode stops sending new requests to the node with the given address,
+// while the requests which are being executed on it, including opened
+// streams, are not interrupted. The node is excluded from the rotation until
+// it's removed from the Pool, the health check doesn't return it back.
+//
+// See also RemoveNode.
+func (p *Pool) DrainNode(address string) error {
+	// prevent concurrent copying of the node state
+	p.updateLock.Lock()
+	defer p.updateLock.Unlock()
+
+	p.lock.RLock()
+	innerPools := p.innerPools
+	p.lock.RUnlock()
+
+	for _, inner := range innerPools {
+		var cp *clientPack
+
+		inner.lock.Lock()
+		for j := range inner.clientPacks {
+			if inner.clientPacks[j].address == address {
+				cp = inner.clientPacks[j]
+				cp.drained = true
+				break
+			}
+		}
+		inner.lock.Unlock()
+
+		if cp != nil {
+			if inner.quarantine(cp) {
+				p.notifyHealth(address, true, false, HealthCauseDrained, nil)
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("node %s not found", address)
+}
@@ -0,0 +1,90 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolNodesUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	closed := make(map[string]bool)
+
+	clientBuilder := func(endpoint string) (client, error) {
+		mockClient := NewMockClient(ctrl)
+		mockClient.EXPECT().sessionCreate(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}) (*resCreateSession, error) {
+			tok := newToken(t)
+			id := tok.ID()
+			return &resCreateSession{
+				id:         id[:],
+				sessionKey: newBinPublicKey(t),
+			}, nil
+		}).AnyTimes()
+		mockClient.EXPECT().endpointInfo(gomock.Any(), gomock.Any()).Return(&netmap.NodeInfo{}, nil).AnyTimes()
+		mockClient.EXPECT().networkInfo(gomock.Any(), gomock.Any()).Return(&netmap.NetworkInfo{}, nil).AnyTimes()
+		mockClient.EXPECT().close().DoAndReturn(func() error {
+			closed[endpoint] = true
+			return nil
+		}).AnyTimes()
+		return mockClient, nil
+	}
+
+	opts := InitParameters{
+		signer:                  neofscryptotest.Signer(),
+		nodeParams:              []NodeParam{{1, "peer0", 1}},
+		clientRebalanceInterval: time.Hour,
+		clientBuilder:           clientBuilder,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(ctx))
+	t.Cleanup(pool.Close)
+
+	require.NoError(t, pool.AddNode(ctx, NewNodeParam(1, "peer1", 1)))
+	require.NoError(t, pool.AddNode(ctx, NewNodeParam(2, "peer2", 1)))
+	require.Error(t, pool.AddNode(ctx, NewNodeParam(1, "peer1", 1)))
+
+	require.Len(t, pool.innerPools, 2)
+	require.Len(t, pool.innerPools[0].clientPacks, 2)
+	require.Equal(t, []float64{0.5, 0.5}, pool.innerPools[0].weights)
+
+	require.NoError(t, pool.DrainNode("peer0"))
+	require.Error(t, pool.DrainNode("peer3"))
+
+	checkDrained := func() {
+		for i := 0; i < 10; i++ {
+			cp, err := pool.connection()
+			require.NoError(t, err)
+			require.Equal(t, "peer1", cp.address)
+		}
+	}
+
+	checkDrained()
+
+	// health check doesn't return drained node
+	pool.updateNodesHealth(ctx)
+	checkDrained()
+
+	// drained node stays drained after the nodes update
+	require.NoError(t, pool.AddNode(ctx, NewNodeParam(3, "peer3", 1)))
+	checkDrained()
+	require.Empty(t, closed)
+
+	require.NoError(t, pool.RemoveNode("peer0"))
+	require.Error(t, pool.RemoveNode("peer0"))
+	require.Equal(t, map[string]bool{"peer0": true}, closed)
+
+	require.Len(t, pool.innerPools, 3)
+	require.Len(t, pool.innerPools[0].clientPacks, 1)
+	require.Equal(t, []float64{1}, pool.innerPools[0].weights)
+}
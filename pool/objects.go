@@ -0,0 +1,120 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// attributeExpirationEpoch is a system attribute of the object which defines
+// the last epoch of the object's life.
+const attributeExpirationEpoch = "__NEOFS__EXPIRATION_EPOCH"
+
+// withAttribute returns copy of the object header with the attribute set to
+// the given value. Payload is shared with the original object which isn't
+// modified.
+func withAttribute(hdr object.Object, key, value string) object.Object {
+	objV2 := *hdr.ToV2()
+	if h := objV2.GetHeader(); h != nil {
+		hCopy := *h
+		objV2.SetHeader(&hCopy)
+	} else {
+		objV2.SetHeader(new(v2object.Header))
+	}
+
+	res := *object.NewFromV2(&objV2)
+
+	attrs := res.Attributes()
+	found := false
+
+	for i := range attrs {
+		if attrs[i].Key() == key {
+			attrs[i].SetValue(value)
+			found = true
+		}
+	}
+
+	if !found {
+		attr := object.NewAttribute()
+		attr.SetKey(key)
+		attr.SetValue(value)
+
+		attrs = append(attrs, *attr)
+	}
+
+	res.SetAttributes(attrs...)
+
+	return res
+}
+
+// LockObjects locks the objects of the container against deletion until the
+// given epoch (inclusive). Lock is a special LOCK-type object stored in the
+// same container, its owner is the Pool's signer. Returns identifier of the
+// stored lock object.
+func (p *Pool) LockObjects(ctx context.Context, cnr cid.ID, ids []oid.ID, untilEpoch uint64) (*oid.ID, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("no objects to lock")
+	}
+
+	var owner user.ID
+
+	if err := user.IDFromSigner(&owner, p.signer); err != nil {
+		return nil, fmt.Errorf("owner from the signer: %w", err)
+	}
+
+	var lock object.Lock
+	lock.WriteMembers(ids)
+
+	hdr := object.New()
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(&owner)
+	object.WriteLock(hdr, lock)
+
+	var prm PrmObjectPut
+	prm.SetHeader(*hdr)
+	prm.SetExpirationEpoch(untilEpoch)
+
+	id, err := p.PutObject(ctx, prm)
+	if err != nil {
+		return nil, fmt.Errorf("store lock object: %w", err)
+	}
+
+	return id, nil
+}
+
+// ListTombstones returns identifiers of all tombstones stored in the container.
+// Tombstone members can be read using GetObject and object.Tombstone.
+//
+// See also DeleteObject.
+func (p *Pool) ListTombstones(ctx context.Context, cnr cid.ID) ([]oid.ID, error) {
+	filters := object.NewSearchFilters()
+	filters.AddTypeFilter(object.MatchStringEqual, object.TypeTombstone)
+
+	var prm PrmObjectSearch
+	prm.SetContainerID(cnr)
+	prm.SetFilters(filters)
+
+	res, err := p.SearchObjects(ctx, prm)
+	if err != nil {
+		return nil, fmt.Errorf("search tombstones: %w", err)
+	}
+
+	var ids []oid.ID
+
+	// reader is closed at the end of iteration
+	err = res.Iterate(func(id oid.ID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read tombstone list: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,105 @@
+package pool
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func expirationEpoch(t *testing.T, hdr object.Object) uint64 {
+	for _, attr := range hdr.Attributes() {
+		if attr.Key() == attributeExpirationEpoch {
+			epoch, err := strconv.ParseUint(attr.Value(), 10, 64)
+			require.NoError(t, err)
+			return epoch
+		}
+	}
+
+	t.Fatal("missing expiration attribute")
+	return 0
+}
+
+func TestWithAttribute(t *testing.T) {
+	attr := object.NewAttribute()
+	attr.SetKey(attributeExpirationEpoch)
+	attr.SetValue("1")
+
+	hdr := object.New()
+	hdr.SetAttributes(*attr)
+
+	res := withAttribute(*hdr, attributeExpirationEpoch, "2")
+	require.EqualValues(t, 2, expirationEpoch(t, res))
+	require.Len(t, res.Attributes(), 1)
+
+	// original header is not modified
+	require.EqualValues(t, 1, expirationEpoch(t, *hdr))
+
+	res = withAttribute(*object.New(), attributeExpirationEpoch, "3")
+	require.EqualValues(t, 3, expirationEpoch(t, res))
+}
+
+func TestPoolLockObjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	cnr := cidtest.ID()
+	members := []oid.ID{oidtest.ID(), oidtest.ID()}
+	lockID := oidtest.ID()
+
+	p := &Pool{
+		cache:  cache,
+		signer: neofscryptotest.Signer(),
+	}
+
+	var owner user.ID
+	require.NoError(t, user.IDFromSigner(&owner, p.signer))
+
+	node := newSessionMock(t, ctrl)
+	node.EXPECT().objectPut(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectPut) (*oid.ID, error) {
+		require.Equal(t, object.TypeLock, prm.hdr.Type())
+		require.True(t, owner.Equals(*prm.hdr.OwnerID()))
+		require.EqualValues(t, 10, expirationEpoch(t, prm.hdr))
+
+		hdrCnr, ok := prm.hdr.ContainerID()
+		require.True(t, ok)
+		require.Equal(t, cnr, hdrCnr)
+
+		var lock object.Lock
+		require.NoError(t, object.ReadLock(&lock, prm.hdr))
+
+		res := make([]oid.ID, lock.NumberOfMembers())
+		lock.ReadMembers(res)
+		require.Equal(t, members, res)
+
+		require.NotNil(t, prm.stoken)
+		require.True(t, prm.stoken.AssertVerb(session.VerbObjectPut))
+		require.True(t, prm.stoken.AssertContainer(cnr))
+
+		return &lockID, nil
+	})
+
+	p.innerPools = []*innerPool{{
+		sampler:     newSampler([]float64{1}, rand.NewSource(0)),
+		clientPacks: []*clientPack{{client: node, healthy: true, address: "node"}},
+	}}
+
+	_, err = p.LockObjects(context.Background(), cnr, nil, 10)
+	require.Error(t, err)
+
+	res, err := p.LockObjects(context.Background(), cnr, members, 10)
+	require.NoError(t, err)
+	require.Equal(t, lockID, *res)
+}
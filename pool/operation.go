@@ -0,0 +1,91 @@
+package pool
+
+// Operation enumerates NeoFS operations executed by Pool on the nodes.
+type Operation uint8
+
+const (
+	// OperationBalanceGet corresponds to Pool.Balance.
+	OperationBalanceGet Operation = iota
+	// OperationContainerPut corresponds to Pool.PutContainer.
+	OperationContainerPut
+	// OperationContainerGet corresponds to Pool.GetContainer.
+	OperationContainerGet
+	// OperationContainerList corresponds to Pool.ListContainers.
+	OperationContainerList
+	// OperationContainerDelete corresponds to Pool.DeleteContainer.
+	OperationContainerDelete
+	// OperationContainerEACL corresponds to Pool.GetEACL.
+	OperationContainerEACL
+	// OperationContainerSetEACL corresponds to Pool.SetEACL.
+	OperationContainerSetEACL
+	// OperationEndpointInfo corresponds to the node health checks.
+	OperationEndpointInfo
+	// OperationNetworkInfo corresponds to Pool.NetworkInfo.
+	OperationNetworkInfo
+	// OperationObjectPut corresponds to Pool.PutObject.
+	OperationObjectPut
+	// OperationObjectDelete corresponds to Pool.DeleteObject.
+	OperationObjectDelete
+	// OperationObjectGet corresponds to Pool.GetObject.
+	OperationObjectGet
+	// OperationObjectHead corresponds to Pool.HeadObject.
+	OperationObjectHead
+	// OperationObjectRange corresponds to Pool.ObjectRange.
+	OperationObjectRange
+	// OperationObjectSearch corresponds to Pool.SearchObjects.
+	OperationObjectSearch
+	// OperationSessionCreate corresponds to opening of the sessions by Pool.
+	OperationSessionCreate
+
+	// operationLast is a number of operations, must be the last one.
+	operationLast
+)
+
+var operationNames = [operationLast]string{
+	OperationBalanceGet:       "BalanceGet",
+	OperationContainerPut:     "ContainerPut",
+	OperationContainerGet:     "ContainerGet",
+	OperationContainerList:    "ContainerList",
+	OperationContainerDelete:  "ContainerDelete",
+	OperationContainerEACL:    "ContainerEACL",
+	OperationContainerSetEACL: "ContainerSetEACL",
+	OperationEndpointInfo:     "EndpointInfo",
+	OperationNetworkInfo:      "NetworkInfo",
+	OperationObjectPut:        "ObjectPut",
+	OperationObjectDelete:     "ObjectDelete",
+	OperationObjectGet:        "ObjectGet",
+	OperationObjectHead:       "ObjectHead",
+	OperationObjectRange:      "ObjectRange",
+	OperationObjectSearch:     "ObjectSearch",
+	OperationSessionCreate:    "SessionCreate",
+}
+
+// String returns name of the operation.
+func (x Operation) String() string {
+	if x < operationLast {
+		return operationNames[x]
+	}
+
+	return "Unknown"
+}
+
+// Idempotent checks if the operation can be executed several times with the
+// same result. Only idempotent operations are retried by default.
+func (x Operation) Idempotent() bool {
+	switch x {
+	case
+		OperationBalanceGet,
+		OperationContainerGet,
+		OperationContainerList,
+		OperationContainerEACL,
+		OperationEndpointInfo,
+		OperationNetworkInfo,
+		OperationObjectGet,
+		OperationObjectHead,
+		OperationObjectRange,
+		OperationObjectSearch:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,179 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// defaultPolicyCacheSize is a number of container placement policies
+// kept in memory.
+const defaultPolicyCacheSize = 1000
+
+// placementRouter calculates the nodes storing the object replicas.
+type placementRouter struct {
+	// placement policies of the containers, they are immutable
+	policies *lru.Cache
+
+	mtx sync.RWMutex
+	nm  *netmap.Netmap
+	// container nodes calculated for the current network map
+	cnrNodes map[cid.ID]netmap.ContainerNodes
+}
+
+func newPlacementRouter() (*placementRouter, error) {
+	policies, err := lru.New(defaultPolicyCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &placementRouter{policies: policies}, nil
+}
+
+// updateNetmap sets current network map. Calculated container nodes are reset.
+func (x *placementRouter) updateNetmap(nodes []netmap.NodeInfo) error {
+	nm, err := netmap.NewNetmap(netmap.NodesFromInfo(nodes))
+	if err != nil {
+		return err
+	}
+
+	x.mtx.Lock()
+	x.nm = nm
+	x.cnrNodes = make(map[cid.ID]netmap.ContainerNodes)
+	x.mtx.Unlock()
+
+	return nil
+}
+
+// placementVectors returns lists of the nodes for each replica of the object
+// sorted by their priority. Returns an error if the network map is unknown.
+func (x *placementRouter) placementVectors(cnr cid.ID, obj oid.ID, policy *netmap.PlacementPolicy) ([]netmap.Nodes, error) {
+	x.mtx.RLock()
+	nm := x.nm
+	cnrNodes, ok := x.cnrNodes[cnr]
+	x.mtx.RUnlock()
+
+	if nm == nil {
+		return nil, errors.New("network map is unknown")
+	}
+
+	if !ok {
+		var err error
+
+		cnrNodes, err = nm.GetContainerNodes(policy, cnr[:])
+		if err != nil {
+			return nil, fmt.Errorf("calculate container nodes: %w", err)
+		}
+
+		x.mtx.Lock()
+		if x.nm == nm {
+			x.cnrNodes[cnr] = cnrNodes
+		}
+		x.mtx.Unlock()
+	}
+
+	return nm.GetPlacementVectors(cnrNodes, obj[:])
+}
+
+// containerPolicy returns placement policy of the container. Policy is read
+// from the network if it is not cached yet.
+func (p *Pool) containerPolicy(ctx context.Context, cnr cid.ID) (*netmap.PlacementPolicy, error) {
+	if v, ok := p.placement.policies.Get(cnr); ok {
+		return v.(*netmap.PlacementPolicy), nil
+	}
+
+	cp, err := p.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	var prm PrmContainerGet
+	prm.SetContainerID(cnr)
+
+	c, err := cp.client.containerGet(ctx, prm)
+	if err != nil {
+		return nil, fmt.Errorf("read container: %w", err)
+	}
+
+	policy := c.PlacementPolicy()
+	if policy == nil {
+		return nil, errors.New("missing placement policy in container")
+	}
+
+	p.placement.policies.Add(cnr, policy)
+
+	return policy, nil
+}
+
+// placementConnection returns connection to the healthy node storing a replica
+// of the object. Nodes from the groups with lower priority values are preferred,
+// within a group the node which is closer to the beginning of the placement
+// vectors is selected.
+func (p *Pool) placementConnection(ctx context.Context, cnr cid.ID, obj oid.ID) (*clientPack, error) {
+	return p.placementConnectionExcept(ctx, cnr, obj, nil)
+}
+
+// placementConnectionExcept works like placementConnection but skips the nodes
+// with the given addresses.
+func (p *Pool) placementConnectionExcept(ctx context.Context, cnr cid.ID, obj oid.ID, exclude map[string]struct{}) (*clientPack, error) {
+	policy, err := p.containerPolicy(ctx, cnr)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors, err := p.placement.placementVectors(cnr, obj, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make(map[string]int)
+
+	for i := range vectors {
+		for j := range vectors[i] {
+			vectors[i][j].IterateAddresses(func(addr string) bool {
+				endpoint, err := nodeEndpoint(addr)
+				if err != nil {
+					return false
+				}
+
+				if _, ok := ranks[endpoint]; !ok {
+					ranks[endpoint] = j*len(vectors) + i
+				}
+
+				return true
+			})
+		}
+	}
+
+	p.lock.RLock()
+	innerPools := p.innerPools
+	p.lock.RUnlock()
+
+	for _, inner := range innerPools {
+		var res *clientPack
+
+		inner.lock.RLock()
+		for _, cp := range inner.clientPacks {
+			if _, excluded := exclude[cp.address]; !cp.healthy || excluded {
+				continue
+			}
+
+			if rank, ok := ranks[cp.address]; ok && (res == nil || rank < ranks[res.address]) {
+				res = cp
+			}
+		}
+		inner.lock.RUnlock()
+
+		if res != nil {
+			return res, nil
+		}
+	}
+
+	return nil, errors.New("no healthy container node")
+}
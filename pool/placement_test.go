@@ -0,0 +1,111 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlacementConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	const nodesNum = 5
+
+	replica := netmap.NewReplica()
+	replica.SetCount(1)
+
+	policy := netmap.NewPlacementPolicy()
+	policy.SetReplicas(*replica)
+
+	var containerReads int
+
+	nodes := make([]netmap.NodeInfo, nodesNum)
+	clientPacks := make([]*clientPack, nodesNum)
+	weights := make([]float64, nodesNum)
+
+	for i := range nodes {
+		nodes[i] = newNodeInfo(fmt.Sprintf("/dns4/node%d/tcp/8080", i))
+
+		mockClient := NewMockClient(ctrl)
+		mockClient.EXPECT().containerGet(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}) (*container.Container, error) {
+			containerReads++
+			return container.New(container.WithPolicy(policy)), nil
+		}).AnyTimes()
+
+		clientPacks[i] = &clientPack{
+			client:  mockClient,
+			healthy: true,
+			address: fmt.Sprintf("node%d:8080", i),
+		}
+		weights[i] = 1.0 / nodesNum
+	}
+
+	router, err := newPlacementRouter()
+	require.NoError(t, err)
+
+	p := &Pool{
+		innerPools: []*innerPool{{
+			sampler:     newSampler(weights, rand.NewSource(0)),
+			clientPacks: clientPacks,
+		}},
+		rebalanceParams: rebalanceParameters{nodesParams: []*nodesParam{{weights: weights}}},
+		placement:       router,
+	}
+
+	cnr := cidtest.ID()
+	obj := oidtest.ID()
+
+	_, err = p.placementConnection(context.Background(), cnr, obj)
+	require.Error(t, err, "network map is unknown")
+
+	require.NoError(t, router.updateNetmap(nodes))
+
+	nm, err := netmap.NewNetmap(netmap.NodesFromInfo(nodes))
+	require.NoError(t, err)
+
+	cnrNodes, err := nm.GetContainerNodes(policy, cnr[:])
+	require.NoError(t, err)
+
+	vectors, err := nm.GetPlacementVectors(cnrNodes, obj[:])
+	require.NoError(t, err)
+	require.Len(t, vectors, 1)
+	require.True(t, len(vectors[0]) > 1)
+
+	nodeAddress := func(n netmap.Node) string {
+		var res string
+		n.IterateAddresses(func(addr string) bool {
+			res, err = nodeEndpoint(addr)
+			require.NoError(t, err)
+			return true
+		})
+		return res
+	}
+
+	cp, err := p.placementConnection(context.Background(), cnr, obj)
+	require.NoError(t, err)
+	require.Equal(t, nodeAddress(vectors[0][0]), cp.address)
+
+	cp.healthy = false
+
+	cp, err = p.placementConnection(context.Background(), cnr, obj)
+	require.NoError(t, err)
+	require.Equal(t, nodeAddress(vectors[0][1]), cp.address)
+
+	// placement policy is read once
+	require.Equal(t, 1, containerReads)
+
+	for i := range clientPacks {
+		clientPacks[i].healthy = false
+	}
+
+	_, err = p.placementConnection(context.Background(), cnr, obj)
+	require.Error(t, err)
+}
@@ -4,23 +4,26 @@ import (
 	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"math/rand"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
 	"github.com/nspcc-dev/neofs-sdk-go/bearer"
 	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -49,27 +52,34 @@ type client interface {
 	objectRange(context.Context, PrmObjectRange) (*ResObjectRange, error)
 	objectSearch(context.Context, PrmObjectSearch) (*ResObjectSearch, error)
 	sessionCreate(context.Context, prmCreateSession) (*resCreateSession, error)
+	close() error
+	statistic() *nodeStat
 }
 
 // clientWrapper is used by default, alternative implementations are intended for testing purposes only.
 type clientWrapper struct {
-	client sdkClient.Client
-	key    ecdsa.PrivateKey
+	client  sdkClient.Client
+	address string
+
+	stat      *nodeStat
+	collector StatisticCollector
 }
 
 type wrapperPrm struct {
 	address              string
-	key                  ecdsa.PrivateKey
+	signer               neofscrypto.Signer
 	timeout              time.Duration
 	responseInfoCallback func(sdkClient.ResponseMetaInfo) error
+	statisticCollector   StatisticCollector
+	tracer               sdkClient.Tracer
 }
 
 func (x *wrapperPrm) setAddress(address string) {
 	x.address = address
 }
 
-func (x *wrapperPrm) setKey(key ecdsa.PrivateKey) {
-	x.key = key
+func (x *wrapperPrm) setSigner(signer neofscrypto.Signer) {
+	x.signer = signer
 }
 
 func (x *wrapperPrm) setTimeout(timeout time.Duration) {
@@ -80,13 +90,26 @@ func (x *wrapperPrm) setResponseInfoCallback(f func(sdkClient.ResponseMetaInfo)
 	x.responseInfoCallback = f
 }
 
+func (x *wrapperPrm) setStatisticCollector(c StatisticCollector) {
+	x.statisticCollector = c
+}
+
+func (x *wrapperPrm) setTracer(t sdkClient.Tracer) {
+	x.tracer = t
+}
+
 func newWrapper(prm wrapperPrm) (*clientWrapper, error) {
 	var prmInit sdkClient.PrmInit
 	prmInit.ResolveNeoFSFailures()
-	prmInit.SetDefaultPrivateKey(prm.key)
+	prmInit.SetDefaultSigner(prm.signer)
 	prmInit.SetResponseInfoCallback(prm.responseInfoCallback)
+	prmInit.SetTracer(prm.tracer)
 
-	res := &clientWrapper{key: prm.key}
+	res := &clientWrapper{
+		address:   prm.address,
+		stat:      new(nodeStat),
+		collector: prm.statisticCollector,
+	}
 
 	res.client.Init(prmInit)
 
@@ -106,7 +129,9 @@ func (c *clientWrapper) balanceGet(ctx context.Context, prm PrmBalanceGet) (*acc
 	var cliPrm sdkClient.PrmBalanceGet
 	cliPrm.SetAccount(prm.account)
 
+	start := time.Now()
 	res, err := c.client.BalanceGet(ctx, cliPrm)
+	c.incRequests(OperationBalanceGet, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +142,9 @@ func (c *clientWrapper) containerPut(ctx context.Context, prm PrmContainerPut) (
 	var cliPrm sdkClient.PrmContainerPut
 	cliPrm.SetContainer(prm.cnr)
 
+	start := time.Now()
 	res, err := c.client.ContainerPut(ctx, cliPrm)
+	c.incRequests(OperationContainerPut, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +160,9 @@ func (c *clientWrapper) containerGet(ctx context.Context, prm PrmContainerGet) (
 	var cliPrm sdkClient.PrmContainerGet
 	cliPrm.SetContainer(prm.cnrID)
 
+	start := time.Now()
 	res, err := c.client.ContainerGet(ctx, cliPrm)
+	c.incRequests(OperationContainerGet, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +173,9 @@ func (c *clientWrapper) containerList(ctx context.Context, prm PrmContainerList)
 	var cliPrm sdkClient.PrmContainerList
 	cliPrm.SetAccount(prm.ownerID)
 
+	start := time.Now()
 	res, err := c.client.ContainerList(ctx, cliPrm)
+	c.incRequests(OperationContainerList, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +189,10 @@ func (c *clientWrapper) containerDelete(ctx context.Context, prm PrmContainerDel
 		cliPrm.WithinSession(prm.stoken)
 	}
 
-	if _, err := c.client.ContainerDelete(ctx, cliPrm); err != nil {
+	start := time.Now()
+	_, err := c.client.ContainerDelete(ctx, cliPrm)
+	c.incRequests(OperationContainerDelete, time.Since(start), err)
+	if err != nil {
 		return err
 	}
 
@@ -173,7 +207,9 @@ func (c *clientWrapper) containerEACL(ctx context.Context, prm PrmContainerEACL)
 	var cliPrm sdkClient.PrmContainerEACL
 	cliPrm.SetContainer(prm.cnrID)
 
+	start := time.Now()
 	res, err := c.client.ContainerEACL(ctx, cliPrm)
+	c.incRequests(OperationContainerEACL, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +220,10 @@ func (c *clientWrapper) containerSetEACL(ctx context.Context, prm PrmContainerSe
 	var cliPrm sdkClient.PrmContainerSetEACL
 	cliPrm.SetTable(prm.table)
 
-	if _, err := c.client.ContainerSetEACL(ctx, cliPrm); err != nil {
+	start := time.Now()
+	_, err := c.client.ContainerSetEACL(ctx, cliPrm)
+	c.incRequests(OperationContainerSetEACL, time.Since(start), err)
+	if err != nil {
 		return err
 	}
 
@@ -201,7 +240,9 @@ func (c *clientWrapper) containerSetEACL(ctx context.Context, prm PrmContainerSe
 }
 
 func (c *clientWrapper) endpointInfo(ctx context.Context, _ prmEndpointInfo) (*netmap.NodeInfo, error) {
+	start := time.Now()
 	res, err := c.client.EndpointInfo(ctx, sdkClient.PrmEndpointInfo{})
+	c.incRequests(OperationEndpointInfo, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
@@ -209,14 +250,21 @@ func (c *clientWrapper) endpointInfo(ctx context.Context, _ prmEndpointInfo) (*n
 }
 
 func (c *clientWrapper) networkInfo(ctx context.Context, _ prmNetworkInfo) (*netmap.NetworkInfo, error) {
+	start := time.Now()
 	res, err := c.client.NetworkInfo(ctx, sdkClient.PrmNetworkInfo{})
+	c.incRequests(OperationNetworkInfo, time.Since(start), err)
 	if err != nil {
 		return nil, err
 	}
 	return res.Info(), nil
 }
 
-func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (*oid.ID, error) {
+func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (_ *oid.ID, err error) {
+	start := time.Now()
+	defer func() {
+		c.incRequests(OperationObjectPut, time.Since(start), err)
+	}()
+
 	var cliPrm sdkClient.PrmObjectPutInit
 	wObj, err := c.client.ObjectPutInit(ctx, cliPrm)
 	if err != nil {
@@ -226,16 +274,20 @@ func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (*oid.I
 	if prm.stoken != nil {
 		wObj.WithinSession(*prm.stoken)
 	}
-	if prm.key != nil {
-		wObj.UseKey(*prm.key)
+	if prm.signer != nil {
+		wObj.UseSigner(prm.signer)
 	}
 
 	if prm.btoken != nil {
 		wObj.WithBearerToken(*prm.btoken)
 	}
 
+	// payload stream provided by the caller can't be re-read
+	streamed := false
+
 	if wObj.WriteHeader(prm.hdr) {
 		sz := prm.hdr.PayloadSize()
+		streamed = prm.payload != nil
 
 		if data := prm.hdr.Payload(); len(data) > 0 {
 			if prm.payload != nil {
@@ -271,14 +323,20 @@ func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (*oid.I
 					break
 				}
 
-				return nil, fmt.Errorf("read payload: %w", err)
+				_, _ = wObj.Close()
+				return nil, partialPutError{fmt.Errorf("read payload: %w", err)}
 			}
 		}
 	}
 
 	res, err := wObj.Close()
 	if err != nil { // here err already carries both status and client errors
-		return nil, fmt.Errorf("client failure: %w", err)
+		err = fmt.Errorf("client failure: %w", err)
+		if streamed {
+			return nil, partialPutError{err}
+		}
+
+		return nil, err
 	}
 
 	var id oid.ID
@@ -303,10 +361,12 @@ func (c *clientWrapper) objectDelete(ctx context.Context, prm PrmObjectDelete) e
 		cliPrm.WithBearerToken(*prm.btoken)
 	}
 
-	if prm.key != nil {
-		cliPrm.UseKey(*prm.key)
+	if prm.signer != nil {
+		cliPrm.UseSigner(prm.signer)
 	}
+	start := time.Now()
 	_, err := c.client.ObjectDelete(ctx, cliPrm)
+	c.incRequests(OperationObjectDelete, time.Since(start), err)
 	return err
 }
 
@@ -325,20 +385,28 @@ func (c *clientWrapper) objectGet(ctx context.Context, prm PrmObjectGet) (*ResGe
 
 	var res ResGetObject
 
+	start := time.Now()
 	rObj, err := c.client.ObjectGetInit(ctx, cliPrm)
 	if err != nil {
+		c.incRequests(OperationObjectGet, time.Since(start), err)
 		return nil, fmt.Errorf("init object reading on client: %w", err)
 	}
 
-	if prm.key != nil {
-		rObj.UseKey(*prm.key)
+	if prm.signer != nil {
+		rObj.UseSigner(prm.signer)
 	}
 
 	if !rObj.ReadHeader(&res.Header) {
 		_, err = rObj.Close()
+		if err == nil {
+			err = errors.New("missing object header in response")
+		}
+		c.incRequests(OperationObjectGet, time.Since(start), err)
 		return nil, fmt.Errorf("read header: %w", err)
 	}
 
+	c.incRequests(OperationObjectGet, time.Since(start), nil)
+
 	res.Payload = (*objectReadCloser)(rObj)
 
 	return &res, nil
@@ -357,13 +425,15 @@ func (c *clientWrapper) objectHead(ctx context.Context, prm PrmObjectHead) (*obj
 		cliPrm.WithBearerToken(*prm.btoken)
 	}
 
-	if prm.key != nil {
-		cliPrm.UseKey(*prm.key)
+	if prm.signer != nil {
+		cliPrm.UseSigner(prm.signer)
 	}
 
 	var obj object.Object
 
+	start := time.Now()
 	res, err := c.client.ObjectHead(ctx, cliPrm)
+	c.incRequests(OperationObjectHead, time.Since(start), err)
 	if err != nil {
 		return nil, fmt.Errorf("read object header via client: %w", err)
 	}
@@ -389,12 +459,14 @@ func (c *clientWrapper) objectRange(ctx context.Context, prm PrmObjectRange) (*R
 		cliPrm.WithBearerToken(*prm.btoken)
 	}
 
+	start := time.Now()
 	res, err := c.client.ObjectRangeInit(ctx, cliPrm)
+	c.incRequests(OperationObjectRange, time.Since(start), err)
 	if err != nil {
 		return nil, fmt.Errorf("init payload range reading on client: %w", err)
 	}
-	if prm.key != nil {
-		res.UseKey(*prm.key)
+	if prm.signer != nil {
+		res.UseSigner(prm.signer)
 	}
 
 	return &ResObjectRange{payload: res}, nil
@@ -414,12 +486,14 @@ func (c *clientWrapper) objectSearch(ctx context.Context, prm PrmObjectSearch) (
 		cliPrm.WithBearerToken(*prm.btoken)
 	}
 
+	start := time.Now()
 	res, err := c.client.ObjectSearchInit(ctx, cliPrm)
+	c.incRequests(OperationObjectSearch, time.Since(start), err)
 	if err != nil {
 		return nil, fmt.Errorf("init object searching on client: %w", err)
 	}
-	if prm.key != nil {
-		res.UseKey(*prm.key)
+	if prm.signer != nil {
+		res.UseSigner(prm.signer)
 	}
 
 	return &ResObjectSearch{r: res}, nil
@@ -429,7 +503,9 @@ func (c *clientWrapper) sessionCreate(ctx context.Context, prm prmCreateSession)
 	var cliPrm sdkClient.PrmSessionCreate
 	cliPrm.SetExp(prm.exp)
 
+	start := time.Now()
 	res, err := c.client.SessionCreate(ctx, cliPrm)
+	c.incRequests(OperationSessionCreate, time.Since(start), err)
 	if err != nil {
 		return nil, fmt.Errorf("session creation on client: %w", err)
 	}
@@ -440,22 +516,70 @@ func (c *clientWrapper) sessionCreate(ctx context.Context, prm prmCreateSession)
 	}, nil
 }
 
+func (c *clientWrapper) close() error {
+	return c.client.Close()
+}
+
+func (c *clientWrapper) statistic() *nodeStat {
+	return c.stat
+}
+
+// incRequests records the result of the operation executed on the node.
+func (c *clientWrapper) incRequests(op Operation, latency time.Duration, err error) {
+	if c.stat != nil {
+		c.stat.record(op, latency, err)
+	}
+
+	if c.collector != nil {
+		c.collector.CollectOperation(c.address, op, latency, err)
+	}
+}
+
 // InitParameters contains values used to initialize connection Pool.
 type InitParameters struct {
-	key                       *ecdsa.PrivateKey
+	signer                    neofscrypto.Signer
 	logger                    *zap.Logger
 	nodeDialTimeout           time.Duration
 	healthcheckTimeout        time.Duration
 	clientRebalanceInterval   time.Duration
 	sessionExpirationDuration uint64
 	nodeParams                []NodeParam
+	nodeDiscovery             *NodeDiscoveryParameters
+	placementRouting          bool
+	sharedSessions            bool
+	retryPolicy               RetryPolicy
+	statisticCollector        StatisticCollector
+	tracer                    sdkClient.Tracer
+	errorThreshold            uint32
+	errorRateThreshold        float64
+	errorRateWindow           uint32
+	quarantineDuration        time.Duration
+	hedging                   hedgingParameters
+	healthHandler             func(NodeHealthEvent)
+	latencyWeighting          latencyWeighting
+	globalLimits              Limits
+	nodeLimits                Limits
+	failFastLimits            bool
 
 	clientBuilder func(endpoint string) (client, error)
 }
 
 // SetKey specifies default key to be used for the protocol communication by default.
+//
+// See also SetSigner.
 func (x *InitParameters) SetKey(key *ecdsa.PrivateKey) {
-	x.key = key
+	if key == nil {
+		x.signer = nil
+		return
+	}
+
+	x.signer = neofsecdsa.Signer(*key)
+}
+
+// SetSigner specifies default signer to be used for the protocol communication
+// by default. Overrides SetKey.
+func (x *InitParameters) SetSigner(signer neofscrypto.Signer) {
+	x.signer = signer
 }
 
 // SetLogger specifies logger.
@@ -487,16 +611,169 @@ func (x *InitParameters) SetSessionExpirationDuration(expirationDuration uint64)
 	x.sessionExpirationDuration = expirationDuration
 }
 
+// SetErrorThreshold specifies the number of consecutive failed requests
+// after which the node is quarantined: it is excluded from the rotation
+// immediately, without waiting for the health check. Only transport failures
+// and internal server errors are counted. Zero disables the threshold.
+//
+// See also SetQuarantineDuration.
+func (x *InitParameters) SetErrorThreshold(threshold uint32) {
+	x.errorThreshold = threshold
+}
+
+// SetErrorRateThreshold specifies the share of the failed requests among the
+// last window ones after which the node is quarantined. Rate must be in (0; 1],
+// zero disables the threshold. Zero window means 100 requests.
+//
+// See also SetErrorThreshold, SetQuarantineDuration.
+func (x *InitParameters) SetErrorRateThreshold(rate float64, window uint32) {
+	x.errorRateThreshold = rate
+	x.errorRateWindow = window
+}
+
+// SetQuarantineDuration specifies the time during which the quarantined node
+// is excluded from the rotation. After that, the node is probed on the next
+// health check and returns to the rotation if it's healthy. By default, it is
+// equal to the client rebalance interval.
+//
+// See also SetClientRebalanceInterval.
+func (x *InitParameters) SetQuarantineDuration(d time.Duration) {
+	x.quarantineDuration = d
+}
+
 // AddNode append information about the node to which you want to connect.
 func (x *InitParameters) AddNode(nodeParam NodeParam) {
 	x.nodeParams = append(x.nodeParams, nodeParam)
 }
 
+// SetNodeDiscovery enables automatic discovery of the nodes from the network map.
+// Nodes added via AddNode are used as bootstrap ones: they are always kept in
+// the Pool, while the other nodes are added and removed according to the
+// network map changes.
+//
+// See also NodeDiscoveryParameters.
+func (x *InitParameters) SetNodeDiscovery(prm NodeDiscoveryParameters) {
+	x.nodeDiscovery = &prm
+}
+
+// EnablePlacementRouting makes Pool to send object reading requests
+// (GetObject, HeadObject and ObjectRange) directly to the healthy container
+// node which stores a replica of the requested object. Nodes are calculated
+// using the network map from the node discovery, so the discovery must be
+// enabled. If there is no such node in the Pool, the request is sent to
+// any healthy node as usual.
+//
+// See also SetNodeDiscovery.
+func (x *InitParameters) EnablePlacementRouting() {
+	x.placementRouting = true
+}
+
+// EnableSharedSessions makes Pool to share default object sessions between
+// the nodes: session is opened once per container, operation and key, and the
+// token is used on any node until it expires. It is useful for writing the
+// objects split into several parts through different nodes. If the node
+// doesn't know the session, own session of the node is opened transparently
+// and the operation is repeated, the node uses its own session afterwards.
+// Objects with the streamed payload are written to the nodes not known to
+// accept the shared session within their own sessions, so the writing is not
+// broken by the unknown session.
+//
+// By default, sessions are opened on each node separately.
+func (x *InitParameters) EnableSharedSessions() {
+	x.sharedSessions = true
+}
+
+// SetRetryPolicy specifies policy of the failed operation retries.
+// By default, operations are not retried.
+func (x *InitParameters) SetRetryPolicy(policy RetryPolicy) {
+	x.retryPolicy = policy
+}
+
+// SetHedging enables hedged object reads (GetObject and HeadObject): if the
+// node doesn't respond within the delay, the same request is sent to another
+// healthy node, and the first successful response is taken while the rest
+// requests are cancelled. Hedged requests are sent every delay until maxHedges
+// additional requests are sent. The nodes are selected from the placement
+// vectors of the object if placement routing is enabled, otherwise from the
+// node group with the highest priority which has healthy nodes left.
+//
+// By default, hedging is disabled.
+//
+// See also EnablePlacementRouting.
+func (x *InitParameters) SetHedging(delay time.Duration, maxHedges int) {
+	x.hedging = hedgingParameters{delay: delay, maxHedges: maxHedges}
+}
+
+// SetLatencyWeighting enables latency-aware node selection: on each rebalance,
+// weights of the healthy nodes within the group are recalculated by their
+// average latency of the health checks and successful requests, so faster
+// nodes receive more requests. Average is an exponentially weighted moving
+// one with the given smoothing factor in (0; 1], greater values make the
+// recent latencies more significant. The weight of the fastest node is equal
+// to its configured weight, the weights of other nodes are decreased
+// proportionally to their latency, but not below the given floor share in
+// [0; 1] of the configured weight. NewPool fails if the parameters are out of
+// these ranges.
+//
+// By default, node weights are static.
+func (x *InitParameters) SetLatencyWeighting(smoothing, floor float64) {
+	x.latencyWeighting = latencyWeighting{smoothing: smoothing, floor: floor}
+}
+
+// SetGlobalLimits specifies limits of the object operations executed by Pool
+// on all nodes together. By default, operations are not limited.
+//
+// See also SetNodeLimits, EnableFailFastLimits.
+func (x *InitParameters) SetGlobalLimits(l Limits) {
+	x.globalLimits = l
+}
+
+// SetNodeLimits specifies limits of the object operations executed by Pool
+// on each node. By default, operations are not limited.
+//
+// See also SetGlobalLimits, EnableFailFastLimits.
+func (x *InitParameters) SetNodeLimits(l Limits) {
+	x.nodeLimits = l
+}
+
+// EnableFailFastLimits makes Pool to return ErrLimitExceeded immediately if
+// the operation exceeds the limits. By default, operations wait until they
+// fit the limits or the context is done.
+func (x *InitParameters) EnableFailFastLimits() {
+	x.failFastLimits = true
+}
+
+// SetNodeHealthHandler specifies function which is called on each change of
+// the node health. The handler is called synchronously, so it should not
+// block. Must be safe for concurrent use.
+func (x *InitParameters) SetNodeHealthHandler(f func(NodeHealthEvent)) {
+	x.healthHandler = f
+}
+
+// SetStatisticCollector specifies collector of the operation results in
+// addition to the statistics accumulated by Pool.
+//
+// See also Pool.Statistic.
+func (x *InitParameters) SetStatisticCollector(c StatisticCollector) {
+	x.statisticCollector = c
+}
+
+// SetTracer specifies tracer of the operations. Spans are opened by the
+// clients of the nodes, so each attempt to execute the operation (see
+// SetRetryPolicy and SetHedging) has its own span with the node address.
+//
+// See also client.PrmInit.SetTracer.
+func (x *InitParameters) SetTracer(t sdkClient.Tracer) {
+	x.tracer = t
+}
+
 type rebalanceParameters struct {
 	nodesParams               []*nodesParam
 	nodeRequestTimeout        time.Duration
 	clientRebalanceInterval   time.Duration
 	sessionExpirationDuration uint64
+	breaker                   breakerParameters
+	latency                   latencyWeighting
 }
 
 type nodesParam struct {
@@ -570,10 +847,17 @@ type clientPack struct {
 	client  client
 	healthy bool
 	address string
+	breaker *circuitBreaker
+	// drained node doesn't accept new requests
+	drained bool
+	latency *latencyEstimator
+	limiter *limiter
 }
 
 type prmContext struct {
+	op             Operation
 	defaultSession bool
+	placement      bool
 	verb           session.ObjectVerb
 	cnr            cid.ID
 
@@ -581,6 +865,10 @@ type prmContext struct {
 	obj    oid.ID
 }
 
+func (x *prmContext) useOperation(op Operation) {
+	x.op = op
+}
+
 func (x *prmContext) useDefaultSession() {
 	x.defaultSession = true
 }
@@ -599,16 +887,35 @@ func (x *prmContext) useVerb(verb session.ObjectVerb) {
 	x.verb = verb
 }
 
+// usePlacement makes the request to be sent to the container node storing
+// the object if placement routing is enabled.
+func (x *prmContext) usePlacement() {
+	x.placement = true
+}
+
 type prmCommon struct {
-	key    *ecdsa.PrivateKey
+	signer neofscrypto.Signer
 	btoken *bearer.Token
 	stoken *session.Object
 }
 
 // UseKey specifies private key to sign the requests.
 // If key is not provided, then Pool default key is used.
+//
+// See also UseSigner.
 func (x *prmCommon) UseKey(key *ecdsa.PrivateKey) {
-	x.key = key
+	if key == nil {
+		x.signer = nil
+		return
+	}
+
+	x.signer = neofsecdsa.Signer(*key)
+}
+
+// UseSigner specifies signer of the requests. Overrides UseKey.
+// If signer is not provided, then Pool default signer is used.
+func (x *prmCommon) UseSigner(signer neofscrypto.Signer) {
+	x.signer = signer
 }
 
 // UseBearer attaches bearer token to be used for the operation.
@@ -628,6 +935,9 @@ type PrmObjectPut struct {
 	hdr object.Object
 
 	payload io.Reader
+
+	expirationSet bool
+	expiration    uint64
 }
 
 // SetHeader specifies header of the object.
@@ -640,6 +950,14 @@ func (x *PrmObjectPut) SetPayload(payload io.Reader) {
 	x.payload = payload
 }
 
+// SetExpirationEpoch specifies the last epoch of the object's life. After it,
+// the object is removed from NeoFS. Overrides expiration attribute of the
+// header if any, the header passed to SetHeader is not modified.
+func (x *PrmObjectPut) SetExpirationEpoch(epoch uint64) {
+	x.expiration = epoch
+	x.expirationSet = true
+}
+
 // PrmObjectDelete groups parameters of DeleteObject operation.
 type PrmObjectDelete struct {
 	prmCommon
@@ -657,6 +975,8 @@ type PrmObjectGet struct {
 	prmCommon
 
 	addr oid.Address
+
+	resumes int
 }
 
 // SetAddress specifies NeoFS address of the object.
@@ -664,6 +984,17 @@ func (x *PrmObjectGet) SetAddress(addr oid.Address) {
 	x.addr = addr
 }
 
+// SetMaxResumes enables resuming of the payload reading after the node
+// failures. Broken payload stream is continued by the range request from the
+// current offset on any healthy node, at most n times. The object header is
+// re-checked before each resume. By default, stream failures are returned
+// from the payload reader.
+//
+// See also client.ResumablePayload.
+func (x *PrmObjectGet) SetMaxResumes(n int) {
+	x.resumes = n
+}
+
 // PrmObjectHead groups parameters of HeadObject operation.
 type PrmObjectHead struct {
 	prmCommon
@@ -877,8 +1208,15 @@ type resCreateSession struct {
 //
 // See pool package overview to get some examples.
 type Pool struct {
+	// protects innerPools and rebalanceParams.nodesParams which are
+	// replaced when the set of nodes changes
+	lock sync.RWMutex
+	// serializes updates of the set of nodes
+	updateLock sync.Mutex
+
 	innerPools      []*innerPool
-	key             *ecdsa.PrivateKey
+	nodeParams      []NodeParam
+	signer          neofscrypto.Signer
 	cancel          context.CancelFunc
 	closedCh        chan struct{}
 	cache           *sessionCache
@@ -886,12 +1224,27 @@ type Pool struct {
 	rebalanceParams rebalanceParameters
 	clientBuilder   func(endpoint string) (client, error)
 	logger          *zap.Logger
+	discovery       *nodeDiscovery
+	placement       *placementRouter
+	retryPolicy     RetryPolicy
+	hedging         hedgingParameters
+	sharedSessions  bool
+	healthHandler   func(NodeHealthEvent)
+	limiter         *limiter
+	nodeLimits      Limits
+	failFastLimits  bool
+	// true if there are global or per-node limits
+	limited bool
+	// running operations and open result streams
+	inflight inflight
 }
 
 type innerPool struct {
 	lock        sync.RWMutex
 	sampler     *sampler
 	clientPacks []*clientPack
+	// probabilities of the sampler
+	weights []float64
 }
 
 const (
@@ -899,14 +1252,21 @@ const (
 
 	defaultRebalanceInterval = 25 * time.Second
 	defaultRequestTimeout    = 4 * time.Second
+	defaultDialTimeout       = 5 * time.Second
+
+	defaultErrorRateWindow = 100
 )
 
 // NewPool creates connection pool using parameters.
 func NewPool(options InitParameters) (*Pool, error) {
-	if options.key == nil {
+	if options.signer == nil {
 		return nil, fmt.Errorf("missed required parameter 'Key'")
 	}
 
+	if err := options.latencyWeighting.verify(); err != nil {
+		return nil, fmt.Errorf("invalid latency weighting: %w", err)
+	}
+
 	nodesParams, err := adjustNodeParams(options.nodeParams)
 	if err != nil {
 		return nil, err
@@ -920,7 +1280,8 @@ func NewPool(options InitParameters) (*Pool, error) {
 	fillDefaultInitParams(&options, cache)
 
 	pool := &Pool{
-		key:            options.key,
+		signer:         options.signer,
+		nodeParams:     options.nodeParams,
 		cache:          cache,
 		logger:         options.logger,
 		stokenDuration: options.sessionExpirationDuration,
@@ -929,8 +1290,42 @@ func NewPool(options InitParameters) (*Pool, error) {
 			nodeRequestTimeout:        options.healthcheckTimeout,
 			clientRebalanceInterval:   options.clientRebalanceInterval,
 			sessionExpirationDuration: options.sessionExpirationDuration,
+			breaker: breakerParameters{
+				errorThreshold:     options.errorThreshold,
+				errorRateThreshold: options.errorRateThreshold,
+				errorRateWindow:    options.errorRateWindow,
+				quarantineDuration: options.quarantineDuration,
+			},
+			latency: options.latencyWeighting,
 		},
-		clientBuilder: options.clientBuilder,
+		clientBuilder:  options.clientBuilder,
+		retryPolicy:    options.retryPolicy,
+		hedging:        options.hedging,
+		sharedSessions: options.sharedSessions,
+		healthHandler:  options.healthHandler,
+		limiter:        newLimiter(options.globalLimits),
+		nodeLimits:     options.nodeLimits,
+		failFastLimits: options.failFastLimits,
+	}
+
+	pool.limited = pool.limiter != nil || newLimiter(options.nodeLimits) != nil
+
+	if options.nodeDiscovery != nil {
+		pool.discovery, err = newNodeDiscovery(*options.nodeDiscovery, options.nodeParams)
+		if err != nil {
+			return nil, fmt.Errorf("init node discovery: %w", err)
+		}
+	}
+
+	if options.placementRouting {
+		if pool.discovery == nil {
+			return nil, errors.New("placement routing requires node discovery")
+		}
+
+		pool.placement, err = newPlacementRouter()
+		if err != nil {
+			return nil, fmt.Errorf("init placement router: %w", err)
+		}
 	}
 
 	return pool, nil
@@ -943,7 +1338,11 @@ func NewPool(options InitParameters) (*Pool, error) {
 //
 // If failed, the Pool SHOULD NOT be used.
 //
-// See also InitParameters.SetClientRebalanceInterval.
+// If node discovery is enabled, Dial also fetches the network map and
+// connects to the discovered nodes. Discovery failure is not fatal: Pool
+// works with the bootstrap nodes until the next successful attempt.
+//
+// See also InitParameters.SetClientRebalanceInterval, InitParameters.SetNodeDiscovery.
 func (p *Pool) Dial(ctx context.Context) error {
 	inner := make([]*innerPool, len(p.rebalanceParams.nodesParams))
 	var atLeastOneHealthy bool
@@ -951,22 +1350,14 @@ func (p *Pool) Dial(ctx context.Context) error {
 	for i, params := range p.rebalanceParams.nodesParams {
 		clientPacks := make([]*clientPack, len(params.weights))
 		for j, addr := range params.addresses {
-			c, err := p.clientBuilder(addr)
+			cp, err := p.dialNode(ctx, addr)
 			if err != nil {
 				return err
 			}
-			var healthy bool
-			var st session.Object
-			err = initSessionForDuration(ctx, &st, c, p.rebalanceParams.sessionExpirationDuration)
-			if err != nil && p.logger != nil {
-				p.logger.Warn("failed to create neofs session token for client",
-					zap.String("Address", addr),
-					zap.Error(err))
-			} else if err == nil {
-				healthy, atLeastOneHealthy = true, true
-				_ = p.cache.Put(formCacheKey(addr, p.key), st)
+			if cp.healthy {
+				atLeastOneHealthy = true
 			}
-			clientPacks[j] = &clientPack{client: c, healthy: healthy, address: addr}
+			clientPacks[j] = cp
 		}
 		source := rand.NewSource(time.Now().UnixNano())
 		sampl := newSampler(params.weights, source)
@@ -974,6 +1365,7 @@ func (p *Pool) Dial(ctx context.Context) error {
 		inner[i] = &innerPool{
 			sampler:     sampl,
 			clientPacks: clientPacks,
+			weights:     params.weights,
 		}
 	}
 
@@ -986,10 +1378,142 @@ func (p *Pool) Dial(ctx context.Context) error {
 	p.closedCh = make(chan struct{})
 	p.innerPools = inner
 
+	if p.discovery != nil {
+		p.discoverNodes(ctx)
+	}
+
 	go p.startRebalance(ctx)
 	return nil
 }
 
+// dialNode connects to the node with the given address and checks its health
+// by opening the default session.
+func (p *Pool) dialNode(ctx context.Context, addr string) (*clientPack, error) {
+	c, err := p.clientBuilder(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var healthy bool
+	var st session.Object
+
+	err = initSessionForDuration(ctx, &st, c, p.rebalanceParams.sessionExpirationDuration)
+	if err != nil {
+		if p.logger != nil {
+			p.logger.Warn("failed to create neofs session token for client",
+				zap.String("Address", addr),
+				zap.Error(err))
+		}
+
+		p.notifyHealth(addr, false, false, HealthCauseDialFailure, err)
+	} else {
+		healthy = true
+		_ = p.cache.Put(formCacheKey(addr, p.signer), st)
+	}
+
+	return &clientPack{
+		client:  c,
+		healthy: healthy,
+		address: addr,
+		breaker: new(circuitBreaker),
+		latency: new(latencyEstimator),
+		limiter: newLimiter(p.nodeLimits),
+	}, nil
+}
+
+// updateNodes replaces the set of the Pool nodes with the given one.
+// Connections to the already known nodes are kept along with their health
+// status, new nodes are dialed, and connections to the nodes which are
+// missing in the new set are closed.
+func (p *Pool) updateNodes(ctx context.Context, nodeParams []NodeParam) error {
+	p.updateLock.Lock()
+	defer p.updateLock.Unlock()
+
+	return p.setNodes(ctx, nodeParams)
+}
+
+// setNodes is an implementation of updateNodes. Must be called under the
+// update lock.
+func (p *Pool) setNodes(ctx context.Context, nodeParams []NodeParam) error {
+	nodesParams, err := adjustNodeParams(nodeParams)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]*clientPack)
+
+	p.lock.RLock()
+	for _, inner := range p.innerPools {
+		inner.lock.RLock()
+		for _, cp := range inner.clientPacks {
+			known[cp.address] = cp
+		}
+		inner.lock.RUnlock()
+	}
+	p.lock.RUnlock()
+
+	var dialed []*clientPack
+
+	inner := make([]*innerPool, len(nodesParams))
+
+	for i, params := range nodesParams {
+		clientPacks := make([]*clientPack, len(params.addresses))
+		weights := make([]float64, len(params.weights))
+		copy(weights, params.weights)
+
+		for j, addr := range params.addresses {
+			if cp, ok := known[addr]; ok {
+				clientPacks[j] = cp
+				delete(known, addr)
+
+				if !cp.healthy {
+					weights[j] = 0
+				}
+
+				continue
+			}
+
+			cp, err := p.dialNode(ctx, addr)
+			if err != nil {
+				for k := range dialed {
+					_ = dialed[k].client.close()
+				}
+
+				return fmt.Errorf("dial %s: %w", addr, err)
+			}
+
+			dialed = append(dialed, cp)
+			clientPacks[j] = cp
+		}
+
+		probabilities := adjustWeights(weights)
+
+		inner[i] = &innerPool{
+			sampler:     newSampler(probabilities, rand.NewSource(time.Now().UnixNano())),
+			clientPacks: clientPacks,
+			weights:     probabilities,
+		}
+	}
+
+	p.lock.Lock()
+	p.innerPools = inner
+	p.nodeParams = nodeParams
+	p.rebalanceParams.nodesParams = nodesParams
+	p.lock.Unlock()
+
+	for addr, cp := range known {
+		p.cache.DeleteByPrefix(addr)
+
+		if err = cp.client.close(); err != nil && p.logger != nil {
+			p.logger.Warn("failed to close connection to the removed node",
+				zap.String("Address", addr),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
 func fillDefaultInitParams(params *InitParameters, cache *sessionCache) {
 	if params.sessionExpirationDuration == 0 {
 		params.sessionExpirationDuration = defaultSessionTokenExpirationDuration
@@ -1003,16 +1527,30 @@ func fillDefaultInitParams(params *InitParameters, cache *sessionCache) {
 		params.healthcheckTimeout = defaultRequestTimeout
 	}
 
+	if params.nodeDialTimeout <= 0 {
+		params.nodeDialTimeout = defaultDialTimeout
+	}
+
+	if params.errorRateThreshold > 0 && params.errorRateWindow == 0 {
+		params.errorRateWindow = defaultErrorRateWindow
+	}
+
+	if params.quarantineDuration <= 0 {
+		params.quarantineDuration = params.clientRebalanceInterval
+	}
+
 	if params.clientBuilder == nil {
 		params.clientBuilder = func(addr string) (client, error) {
 			var prm wrapperPrm
 			prm.setAddress(addr)
-			prm.setKey(*params.key)
+			prm.setSigner(params.signer)
 			prm.setTimeout(params.nodeDialTimeout)
 			prm.setResponseInfoCallback(func(info sdkClient.ResponseMetaInfo) error {
 				cache.updateEpoch(info.Epoch())
 				return nil
 			})
+			prm.setStatisticCollector(params.statisticCollector)
+			prm.setTracer(params.tracer)
 			return newWrapper(prm)
 		}
 	}
@@ -1049,10 +1587,6 @@ func adjustNodeParams(nodeParams []NodeParam) ([]*nodesParam, error) {
 
 func (p *Pool) startRebalance(ctx context.Context) {
 	ticker := time.NewTimer(p.rebalanceParams.clientRebalanceInterval)
-	buffers := make([][]float64, len(p.rebalanceParams.nodesParams))
-	for i, params := range p.rebalanceParams.nodesParams {
-		buffers[i] = make([]float64, len(params.weights))
-	}
 
 	for {
 		select {
@@ -1060,32 +1594,48 @@ func (p *Pool) startRebalance(ctx context.Context) {
 			close(p.closedCh)
 			return
 		case <-ticker.C:
-			p.updateNodesHealth(ctx, buffers)
+			p.updateNodesHealth(ctx)
+			if p.discovery != nil {
+				p.discoverNodes(ctx)
+			}
 			ticker.Reset(p.rebalanceParams.clientRebalanceInterval)
 		}
 	}
 }
 
-func (p *Pool) updateNodesHealth(ctx context.Context, buffers [][]float64) {
+func (p *Pool) updateNodesHealth(ctx context.Context) {
+	p.lock.RLock()
+	nodesParams := p.rebalanceParams.nodesParams
+	p.lock.RUnlock()
+
 	wg := sync.WaitGroup{}
-	for i, inner := range p.innerPools {
+	for i := range nodesParams {
 		wg.Add(1)
 
-		bufferWeights := buffers[i]
-		go func(i int, innerPool *innerPool) {
+		bufferWeights := make([]float64, len(nodesParams[i].weights))
+		go func(i int) {
 			defer wg.Done()
 			p.updateInnerNodesHealth(ctx, i, bufferWeights)
-		}(i, inner)
+		}(i)
 	}
 	wg.Wait()
 }
 
 func (p *Pool) updateInnerNodesHealth(ctx context.Context, i int, bufferWeights []float64) {
+	p.lock.RLock()
 	if i > len(p.innerPools)-1 {
+		p.lock.RUnlock()
 		return
 	}
 	pool := p.innerPools[i]
+	weights := p.rebalanceParams.nodesParams[i].weights
 	options := p.rebalanceParams
+	p.lock.RUnlock()
+
+	if len(bufferWeights) != len(weights) {
+		// set of nodes has been changed concurrently
+		return
+	}
 
 	healthyChanged := false
 	wg := sync.WaitGroup{}
@@ -1094,45 +1644,112 @@ func (p *Pool) updateInnerNodesHealth(ctx context.Context, i int, bufferWeights
 
 	for j, cPack := range pool.clientPacks {
 		wg.Add(1)
-		go func(j int, cli client) {
+		go func(j int, cli client, breaker *circuitBreaker, latency *latencyEstimator) {
 			defer wg.Done()
 			ok := true
-			tctx, c := context.WithTimeout(ctx, options.nodeRequestTimeout)
-			defer c()
 
-			if _, err := cli.endpointInfo(tctx, prmEndpoint); err != nil {
+			pool.lock.RLock()
+			drained := pool.clientPacks[j].drained
+			pool.lock.RUnlock()
+
+			var quarantined, probe bool
+			if breaker != nil {
+				quarantined, probe = breaker.state(time.Now())
+			}
+
+			var cause NodeHealthCause
+			var err error
+
+			if drained || quarantined {
+				// node stays out of rotation until it's removed or the
+				// quarantine expires
 				ok = false
 				bufferWeights[j] = 0
+
+				cause = HealthCauseErrorThreshold
+				if drained {
+					cause = HealthCauseDrained
+				}
+			} else {
+				tctx, c := context.WithTimeout(ctx, options.nodeRequestTimeout)
+				defer c()
+
+				start := time.Now()
+
+				if _, err = cli.endpointInfo(tctx, prmEndpoint); err != nil {
+					ok = false
+					bufferWeights[j] = 0
+				} else if options.latency.enabled() && latency != nil {
+					latency.observe(time.Since(start), options.latency.smoothing)
+				}
+
+				cause = healthcheckCause(tctx, err)
+
+				if probe {
+					breaker.probed(ok, options.breaker, time.Now())
+				}
 			}
+
 			pool.lock.RLock()
 			cp := *pool.clientPacks[j]
 			pool.lock.RUnlock()
 
 			if ok {
-				bufferWeights[j] = options.nodesParams[i].weights[j]
+				bufferWeights[j] = weights[j]
 			} else {
 				p.cache.DeleteByPrefix(cp.address)
 			}
 
 			pool.lock.Lock()
-			if pool.clientPacks[j].healthy != ok {
+			changed := pool.clientPacks[j].healthy != ok
+			if changed {
 				pool.clientPacks[j].healthy = ok
 				healthyChanged = true
 			}
 			pool.lock.Unlock()
-		}(j, cPack.client)
+
+			if changed {
+				p.notifyHealth(cp.address, !ok, ok, cause, err)
+			}
+		}(j, cPack.client, cPack.breaker, cPack.latency)
 	}
 	wg.Wait()
 
+	if options.latency.enabled() {
+		// weights depend on the latencies, so they change on each check
+		applyLatencyFactors(pool, bufferWeights, options.latency.floor)
+		healthyChanged = true
+	}
+
 	if healthyChanged {
 		probabilities := adjustWeights(bufferWeights)
 		source := rand.NewSource(time.Now().UnixNano())
 		pool.lock.Lock()
 		pool.sampler = newSampler(probabilities, source)
+		pool.weights = probabilities
 		pool.lock.Unlock()
 	}
 }
 
+// applyLatencyFactors multiplies the node weights by their latency factors.
+func applyLatencyFactors(pool *innerPool, weights []float64, floor float64) {
+	latencies := make([]time.Duration, len(weights))
+	measured := make([]bool, len(weights))
+
+	pool.lock.RLock()
+	for j, cp := range pool.clientPacks {
+		if j < len(weights) && cp.latency != nil && weights[j] > 0 {
+			latencies[j], measured[j] = cp.latency.get()
+		}
+	}
+	pool.lock.RUnlock()
+
+	factors := latencyFactors(latencies, measured, floor)
+	for j := range weights {
+		weights[j] *= factors[j]
+	}
+}
+
 func adjustWeights(weights []float64) []float64 {
 	adjusted := make([]float64, len(weights))
 	sum := 0.0
@@ -1149,8 +1766,18 @@ func adjustWeights(weights []float64) []float64 {
 }
 
 func (p *Pool) connection() (*clientPack, error) {
-	for _, inner := range p.innerPools {
-		cp, err := inner.connection()
+	return p.connectionExcept(nil)
+}
+
+// connectionExcept works like connection but skips the nodes with the
+// given addresses.
+func (p *Pool) connectionExcept(exclude map[string]struct{}) (*clientPack, error) {
+	p.lock.RLock()
+	innerPools := p.innerPools
+	p.lock.RUnlock()
+
+	for _, inner := range innerPools {
+		cp, err := inner.connectionExcept(exclude)
 		if err == nil {
 			return cp, nil
 		}
@@ -1160,11 +1787,23 @@ func (p *Pool) connection() (*clientPack, error) {
 }
 
 func (p *innerPool) connection() (*clientPack, error) {
+	return p.connectionExcept(nil)
+}
+
+func (p *innerPool) connectionExcept(exclude map[string]struct{}) (*clientPack, error) {
+	suitable := func(cp *clientPack) bool {
+		if !cp.healthy {
+			return false
+		}
+		_, excluded := exclude[cp.address]
+		return !excluded
+	}
+
 	p.lock.RLock()
 	defer p.lock.RUnlock()
 	if len(p.clientPacks) == 1 {
 		cp := p.clientPacks[0]
-		if cp.healthy {
+		if suitable(cp) {
 			return cp, nil
 		}
 		return nil, errors.New("no healthy client")
@@ -1172,17 +1811,125 @@ func (p *innerPool) connection() (*clientPack, error) {
 	attempts := 3 * len(p.clientPacks)
 	for k := 0; k < attempts; k++ {
 		i := p.sampler.Next()
-		if cp := p.clientPacks[i]; cp.healthy {
+		if cp := p.clientPacks[i]; suitable(cp) {
 			return cp, nil
 		}
 	}
 
+	if len(exclude) > 0 {
+		// sampler is likely to return excluded nodes, so check the rest ones
+		for _, cp := range p.clientPacks {
+			if suitable(cp) {
+				return cp, nil
+			}
+		}
+	}
+
 	return nil, errors.New("no healthy client")
 }
 
-func formCacheKey(address string, key *ecdsa.PrivateKey) string {
-	k := keys.PrivateKey{PrivateKey: *key}
-	return address + k.String()
+// reportNodeResult registers result of the request to the node with the given
+// address: updates the node latency and quarantines the node if its error
+// thresholds are exceeded.
+func (p *Pool) reportNodeResult(address string, latency time.Duration, err error) {
+	prmBreaker := p.rebalanceParams.breaker
+	prmLatency := p.rebalanceParams.latency
+
+	if !prmBreaker.enabled() && !prmLatency.enabled() {
+		return
+	}
+
+	inner, cp := p.findNode(address)
+	if cp == nil {
+		return
+	}
+
+	if err == nil && prmLatency.enabled() && cp.latency != nil {
+		cp.latency.observe(latency, prmLatency.smoothing)
+	}
+
+	if prmBreaker.enabled() && cp.breaker != nil && cp.breaker.report(isNodeFailure(err), prmBreaker, time.Now()) {
+		if inner.quarantine(cp) {
+			p.notifyHealth(address, true, false, HealthCauseErrorThreshold, err)
+		}
+
+		if p.logger != nil {
+			p.logger.Warn("node error threshold exceeded, node is quarantined",
+				zap.String("address", address),
+				zap.Duration("duration", prmBreaker.quarantineDuration))
+		}
+	}
+}
+
+// findNode returns the node with the given address and its group. Returns nil
+// if there is no such node.
+func (p *Pool) findNode(address string) (*innerPool, *clientPack) {
+	p.lock.RLock()
+	innerPools := p.innerPools
+	p.lock.RUnlock()
+
+	for _, inner := range innerPools {
+		inner.lock.RLock()
+		for _, cp := range inner.clientPacks {
+			if cp.address == address {
+				inner.lock.RUnlock()
+				return inner, cp
+			}
+		}
+		inner.lock.RUnlock()
+	}
+
+	return nil, nil
+}
+
+func formCacheKey(address string, signer neofscrypto.Signer) string {
+	b := make([]byte, signer.Public().MaxEncodedSize())
+	b = b[:signer.Public().Encode(b)]
+
+	return address + hex.EncodeToString(b)
+}
+
+// formSharedCacheKey forms cache key of the session shared between the nodes.
+func formSharedCacheKey(cnr cid.ID, verb session.ObjectVerb, signer neofscrypto.Signer) string {
+	return formCacheKey("shared/"+cnr.EncodeToString()+"/"+strconv.Itoa(int(verb))+"/", signer)
+}
+
+// sessionCacheKey returns key of the default session in the cache.
+func (p *Pool) sessionCacheKey(ctx *callContext) string {
+	if ctx.sessionShared {
+		return formSharedCacheKey(ctx.sessionCnr, ctx.sessionVerb, ctx.signer)
+	}
+
+	return formCacheKey(ctx.endpoint, ctx.signer)
+}
+
+// useSharedSession checks if the shared default session should be used on the
+// node of the call context. Shared session is opened on the first node, nodes
+// which don't know it use their own sessions. If it is unknown whether the node
+// knows the session, shared session is tried unless the request payload is
+// streamed.
+func (p *Pool) useSharedSession(ctx *callContext) bool {
+	cacheKey := formSharedCacheKey(ctx.sessionCnr, ctx.sessionVerb, ctx.signer)
+
+	if _, ok := p.cache.Get(cacheKey); !ok {
+		// session will be opened on this node
+		return true
+	}
+
+	known, recorded := p.cache.NodeKnows(cacheKey, ctx.endpoint)
+	if recorded {
+		return known
+	}
+
+	return !ctx.streamsPayload
+}
+
+// isSessionNotFound checks if the node doesn't know the session.
+func isSessionNotFound(err error) bool {
+	var errNotFound apistatus.SessionTokenNotFound
+	var errNotFoundPtr *apistatus.SessionTokenNotFound
+
+	return errors.As(err, &errNotFound) || errors.As(err, &errNotFoundPtr)
 }
 
 func (p *Pool) checkSessionTokenErr(err error, address string) bool {
@@ -1252,7 +1999,14 @@ type callContext struct {
 	endpoint string
 
 	// request signer
-	key *ecdsa.PrivateKey
+	signer neofscrypto.Signer
+
+	// executed operation
+	op Operation
+
+	// closes the result stream of the successful streaming operation:
+	// releases its limits and stops its in-flight tracking
+	release func()
 
 	// flag to open default session if session token is missing
 	sessionDefault bool
@@ -1261,22 +2015,43 @@ type callContext struct {
 	sessionCnr     cid.ID
 	sessionObjSet  bool
 	sessionObj     oid.ID
+
+	// shared default session is used in the current attempt
+	sessionShared bool
+
+	// request payload is streamed, so the request can't be repeated after
+	// the session failure
+	streamsPayload bool
 }
 
 func (p *Pool) initCallContext(ctx *callContext, cfg prmCommon, prmCtx prmContext) error {
-	cp, err := p.connection()
-	if err != nil {
-		return err
+	var cp *clientPack
+	var err error
+
+	if prmCtx.placement && prmCtx.objSet && p.placement != nil {
+		cp, err = p.placementConnection(ctx, prmCtx.cnr, prmCtx.obj)
+		if err != nil && p.logger != nil {
+			p.logger.Debug("failed to select container node, fallback to any node",
+				zap.Error(err))
+		}
 	}
 
-	ctx.key = cfg.key
-	if ctx.key == nil {
-		// use pool key if caller didn't specify its own
-		ctx.key = p.key
+	if cp == nil {
+		cp, err = p.connection()
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.signer = cfg.signer
+	if ctx.signer == nil {
+		// use pool signer if caller didn't specify its own
+		ctx.signer = p.signer
 	}
 
 	ctx.endpoint = cp.address
 	ctx.client = cp.client
+	ctx.op = prmCtx.op
 
 	if ctx.sessionTarget != nil && cfg.stoken != nil {
 		ctx.sessionTarget(*cfg.stoken)
@@ -1297,7 +2072,7 @@ func (p *Pool) initCallContext(ctx *callContext, cfg prmCommon, prmCtx prmContex
 // opens new session or uses cached one.
 // Must be called only on initialized callContext with set sessionTarget.
 func (p *Pool) openDefaultSession(ctx *callContext) error {
-	cacheKey := formCacheKey(ctx.endpoint, ctx.key)
+	cacheKey := p.sessionCacheKey(ctx)
 
 	tok, ok := p.cache.Get(cacheKey)
 	if !ok {
@@ -1309,6 +2084,10 @@ func (p *Pool) openDefaultSession(ctx *callContext) error {
 
 		// cache the opened session
 		p.cache.Put(cacheKey, tok)
+
+		if ctx.sessionShared {
+			p.cache.SetNodeKnows(cacheKey, ctx.endpoint, true)
+		}
 	}
 
 	tok.ForVerb(ctx.sessionVerb)
@@ -1319,7 +2098,7 @@ func (p *Pool) openDefaultSession(ctx *callContext) error {
 	}
 
 	// sign the token
-	if err := tok.Sign(*ctx.key); err != nil {
+	if err := tok.Sign(ctx.signer); err != nil {
 		return fmt.Errorf("sign token of the opened session: %w", err)
 	}
 
@@ -1329,27 +2108,81 @@ func (p *Pool) openDefaultSession(ctx *callContext) error {
 }
 
 // opens default session (if sessionDefault is set), and calls f. If f returns
-// session-related error then cached token is removed.
+// session-related error then cached token is removed. If the node doesn't know
+// the shared session, it is recorded, own session of the node is used, and f
+// is called again. Failed operation is retried on other nodes according to the
+// retry policy: f must use the client from the call context.
 func (p *Pool) call(ctx *callContext, f func() error) error {
-	var err error
+	cp := &clientPack{client: ctx.client, address: ctx.endpoint}
 
-	if ctx.sessionDefault {
-		err = p.openDefaultSession(ctx)
+	return p.execute(ctx, ctx.op, cp, func(cp *clientPack) (err error) {
+		ctx.client = cp.client
+		ctx.endpoint = cp.address
+
+		release, err := p.limitRequest(ctx, ctx.op, ctx.endpoint)
 		if err != nil {
-			return fmt.Errorf("open default session: %w", err)
+			return fmt.Errorf("limit request: %w", err)
 		}
-	}
 
-	err = f()
-	_ = p.checkSessionTokenErr(err, ctx.endpoint)
+		defer func() {
+			// opened streams are released by the results
+			if err == nil && ctx.op.streamsResult() {
+				ctx.release = p.inflight.openStream(ctx.op.String()+" on "+ctx.endpoint, release)
+			} else if release != nil {
+				release()
+			}
+		}()
 
-	return err
+		if ctx.sessionDefault {
+			ctx.sessionShared = p.sharedSessions && p.useSharedSession(ctx)
+
+			err = p.openDefaultSession(ctx)
+			if err != nil {
+				return fmt.Errorf("open default session: %w", err)
+			}
+		}
+
+		err = f()
+
+		if ctx.sessionShared {
+			cacheKey := p.sessionCacheKey(ctx)
+
+			switch {
+			case err == nil:
+				p.cache.SetNodeKnows(cacheKey, ctx.endpoint, true)
+			case isSessionNotFound(err):
+				p.cache.SetNodeKnows(cacheKey, ctx.endpoint, false)
+
+				if errors.As(err, new(partialPutError)) {
+					// payload can't be streamed again, own session of the
+					// node will be used next time
+					break
+				}
+
+				// shared session was opened on another node, so use own
+				// session of the current node and repeat the operation
+				ctx.sessionShared = false
+
+				if err = p.openDefaultSession(ctx); err != nil {
+					return fmt.Errorf("open default session of the node: %w", err)
+				}
+
+				err = f()
+			}
+		}
+
+		if p.checkSessionTokenErr(err, ctx.endpoint) && ctx.sessionShared {
+			p.cache.Delete(p.sessionCacheKey(ctx))
+		}
+
+		return err
+	})
 }
 
 // fillAppropriateKey use pool key if caller didn't specify its own.
 func (p *Pool) fillAppropriateKey(prm *prmCommon) {
-	if prm.key == nil {
-		prm.key = p.key
+	if prm.signer == nil {
+		prm.signer = p.signer
 	}
 }
 
@@ -1358,31 +2191,55 @@ func (p *Pool) PutObject(ctx context.Context, prm PrmObjectPut) (*oid.ID, error)
 	cnr, _ := prm.hdr.ContainerID()
 
 	var prmCtx prmContext
+	prmCtx.useOperation(OperationObjectPut)
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectPut)
 	prmCtx.useContainer(cnr)
 
 	p.fillAppropriateKey(&prm.prmCommon)
 
+	if prm.expirationSet {
+		prm.hdr = withAttribute(prm.hdr, attributeExpirationEpoch, strconv.FormatUint(prm.expiration, 10))
+	}
+
 	var ctxCall callContext
 
 	ctxCall.Context = ctx
+	ctxCall.sessionTarget = prm.UseSession
 
 	if err := p.initCallContext(&ctxCall, prm.prmCommon, prmCtx); err != nil {
 		return nil, fmt.Errorf("init call context")
 	}
 
-	if ctxCall.sessionDefault {
-		ctxCall.sessionTarget = prm.UseSession
-		if err := p.openDefaultSession(&ctxCall); err != nil {
-			return nil, fmt.Errorf("open default session: %w", err)
+	// default session is checked before the payload streaming
+	ctxCall.streamsPayload = prm.payload != nil
+
+	if p.limited && prm.payload != nil {
+		prm.payload = &limitedReader{
+			r: prm.payload,
+			limit: func(n int) error {
+				return p.limitPayload(ctx, ctxCall.endpoint, n)
+			},
 		}
 	}
 
-	id, err := ctxCall.client.objectPut(ctx, prm)
+	var id *oid.ID
+
+	err := p.call(&ctxCall, func() error {
+		var err error
+
+		if p.limited {
+			// payload from the header is sent along with it
+			if err = p.limitPayload(ctx, ctxCall.endpoint, len(prm.hdr.Payload())); err != nil {
+				return fmt.Errorf("limit payload: %w", err)
+			}
+		}
+
+		id, err = ctxCall.client.objectPut(ctx, prm)
+
+		return err
+	})
 	if err != nil {
-		// removes session token from cache in case of token error
-		p.checkSessionTokenErr(err, ctxCall.endpoint)
 		return nil, fmt.Errorf("init writing on API client: %w", err)
 	}
 
@@ -1395,6 +2252,7 @@ func (p *Pool) PutObject(ctx context.Context, prm PrmObjectPut) (*oid.ID, error)
 // Explicit deletion is done asynchronously, and is generally not guaranteed.
 func (p *Pool) DeleteObject(ctx context.Context, prm PrmObjectDelete) error {
 	var prmCtx prmContext
+	prmCtx.useOperation(OperationObjectDelete)
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectDelete)
 	prmCtx.useAddress(prm.addr)
@@ -1443,9 +2301,11 @@ type ResGetObject struct {
 // GetObject reads object header and initiates reading an object payload through a remote server using NeoFS API protocol.
 func (p *Pool) GetObject(ctx context.Context, prm PrmObjectGet) (*ResGetObject, error) {
 	var prmCtx prmContext
+	prmCtx.useOperation(OperationObjectGet)
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectGet)
 	prmCtx.useAddress(prm.addr)
+	prmCtx.usePlacement()
 
 	p.fillAppropriateKey(&prm.prmCommon)
 
@@ -1458,19 +2318,56 @@ func (p *Pool) GetObject(ctx context.Context, prm PrmObjectGet) (*ResGetObject,
 		return nil, err
 	}
 
-	var res *ResGetObject
-	return res, p.call(&cc, func() error {
-		res, err = cc.client.objectGet(ctx, prm)
-		return err
+	res, err := p.hedge(&cc, prmCtx, func(cc *callContext) (interface{}, error) {
+		prm := prm
+		cc.sessionTarget = prm.UseSession
+
+		var res *ResGetObject
+		err := p.call(cc, func() error {
+			var err error
+			res, err = cc.client.objectGet(cc, prm)
+			return err
+		})
+		if err == nil && res != nil {
+			res.Payload = p.newLimitedPayload(cc, res.Payload)
+		}
+
+		return res, err
+	}, func(res interface{}, cancel context.CancelFunc) {
+		// payload is streamed within the context of the request
+		r := res.(*ResGetObject)
+		if r == nil || r.Payload == nil {
+			cancel()
+			return
+		}
+
+		r.Payload = hedgedPayload{ReadCloser: r.Payload, cancel: cancel}
+	}, func(res interface{}) {
+		if r := res.(*ResGetObject); r != nil && r.Payload != nil {
+			_ = r.Payload.Close()
+		}
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := res.(*ResGetObject)
+
+	if prm.resumes > 0 && r.Payload != nil {
+		r.Payload = p.newResumablePayload(ctx, prm, r.Header, r.Payload)
+	}
+
+	return r, nil
 }
 
 // HeadObject reads object header through a remote server using NeoFS API protocol.
 func (p *Pool) HeadObject(ctx context.Context, prm PrmObjectHead) (*object.Object, error) {
 	var prmCtx prmContext
+	prmCtx.useOperation(OperationObjectHead)
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectHead)
 	prmCtx.useAddress(prm.addr)
+	prmCtx.usePlacement()
 
 	p.fillAppropriateKey(&prm.prmCommon)
 
@@ -1484,11 +2381,24 @@ func (p *Pool) HeadObject(ctx context.Context, prm PrmObjectHead) (*object.Objec
 		return nil, err
 	}
 
-	var obj *object.Object
-	return obj, p.call(&cc, func() error {
-		obj, err = cc.client.objectHead(ctx, prm)
-		return err
-	})
+	res, err := p.hedge(&cc, prmCtx, func(cc *callContext) (interface{}, error) {
+		prm := prm
+		cc.sessionTarget = prm.UseSession
+
+		var obj *object.Object
+		err := p.call(cc, func() error {
+			var err error
+			obj, err = cc.client.objectHead(cc, prm)
+			return err
+		})
+
+		return obj, err
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*object.Object), nil
 }
 
 // ResObjectRange is designed to read payload range of one object
@@ -1497,18 +2407,42 @@ func (p *Pool) HeadObject(ctx context.Context, prm PrmObjectHead) (*object.Objec
 // Must be initialized using Pool.ObjectRange, any other
 // usage is unsafe.
 type ResObjectRange struct {
-	payload *sdkClient.ObjectRangeReader
+	payload objectRangeReader
+
+	// payload limits, nil if there are no limits
+	limit func(n int) error
+	// closes the stream, see callContext.release
+	release func()
+}
+
+// objectRangeReader is a stream of the object payload range, implemented by
+// sdkClient.ObjectRangeReader.
+type objectRangeReader interface {
+	io.Reader
+	Close() (*sdkClient.ResObjectRange, error)
 }
 
 // Read implements io.Reader of the object payload.
 func (x *ResObjectRange) Read(p []byte) (int, error) {
-	return x.payload.Read(p)
+	n, err := x.payload.Read(p)
+	if n > 0 && x.limit != nil {
+		if errLimit := x.limit(n); errLimit != nil {
+			return n, errLimit
+		}
+	}
+
+	return n, err
 }
 
 // Close ends reading the payload range and returns the result of the operation
 // along with the final results. Must be called after using the ResObjectRange.
 func (x *ResObjectRange) Close() error {
 	_, err := x.payload.Close()
+
+	if x.release != nil {
+		x.release()
+	}
+
 	return err
 }
 
@@ -1516,9 +2450,11 @@ func (x *ResObjectRange) Close() error {
 // server using NeoFS API protocol.
 func (p *Pool) ObjectRange(ctx context.Context, prm PrmObjectRange) (*ResObjectRange, error) {
 	var prmCtx prmContext
+	prmCtx.useOperation(OperationObjectRange)
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectRange)
 	prmCtx.useAddress(prm.addr)
+	prmCtx.usePlacement()
 
 	p.fillAppropriateKey(&prm.prmCommon)
 
@@ -1533,10 +2469,24 @@ func (p *Pool) ObjectRange(ctx context.Context, prm PrmObjectRange) (*ResObjectR
 
 	var res *ResObjectRange
 
-	return res, p.call(&cc, func() error {
+	err = p.call(&cc, func() error {
 		res, err = cc.client.objectRange(ctx, prm)
 		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	if p.limited {
+		address := cc.endpoint
+		res.limit = func(n int) error {
+			return p.limitPayload(ctx, address, n)
+		}
+	}
+
+	res.release = cc.release
+
+	return res, nil
 }
 
 // ResObjectSearch is designed to read list of object identifiers from NeoFS system.
@@ -1544,6 +2494,9 @@ func (p *Pool) ObjectRange(ctx context.Context, prm PrmObjectRange) (*ResObjectR
 // Must be initialized using Pool.SearchObjects, any other usage is unsafe.
 type ResObjectSearch struct {
 	r *sdkClient.ObjectListReader
+
+	// closes the stream, see callContext.release
+	release func()
 }
 
 // Read reads another list of the object identifiers.
@@ -1551,6 +2504,7 @@ func (x *ResObjectSearch) Read(buf []oid.ID) (int, error) {
 	n, ok := x.r.Read(buf)
 	if !ok {
 		_, err := x.r.Close()
+		x.releaseStream()
 		if err == nil {
 			return n, io.EOF
 		}
@@ -1566,6 +2520,7 @@ func (x *ResObjectSearch) Read(buf []oid.ID) (int, error) {
 //
 // Returns an error if object can't be read.
 func (x *ResObjectSearch) Iterate(f func(oid.ID) bool) error {
+	defer x.releaseStream()
 	return x.r.Iterate(f)
 }
 
@@ -1573,6 +2528,13 @@ func (x *ResObjectSearch) Iterate(f func(oid.ID) bool) error {
 // along with the final results. Must be called after using the ResObjectSearch.
 func (x *ResObjectSearch) Close() {
 	_, _ = x.r.Close()
+	x.releaseStream()
+}
+
+func (x *ResObjectSearch) releaseStream() {
+	if x.release != nil {
+		x.release()
+	}
 }
 
 // SearchObjects initiates object selection through a remote server using NeoFS API protocol.
@@ -1582,6 +2544,7 @@ func (x *ResObjectSearch) Close() {
 // Resulting reader must be finally closed.
 func (p *Pool) SearchObjects(ctx context.Context, prm PrmObjectSearch) (*ResObjectSearch, error) {
 	var prmCtx prmContext
+	prmCtx.useOperation(OperationObjectSearch)
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectSearch)
 	prmCtx.useContainer(prm.cnrID)
@@ -1600,10 +2563,17 @@ func (p *Pool) SearchObjects(ctx context.Context, prm PrmObjectSearch) (*ResObje
 
 	var res *ResObjectSearch
 
-	return res, p.call(&cc, func() error {
+	err = p.call(&cc, func() error {
 		res, err = cc.client.objectSearch(ctx, prm)
 		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	res.release = cc.release
+
+	return res, nil
 }
 
 // PutContainer sends request to save container in NeoFS and waits for the operation to complete.
@@ -1619,7 +2589,14 @@ func (p *Pool) PutContainer(ctx context.Context, prm PrmContainerPut) (*cid.ID,
 		return nil, err
 	}
 
-	return cp.client.containerPut(ctx, prm)
+	var res *cid.ID
+
+	err = p.execute(ctx, OperationContainerPut, cp, func(cp *clientPack) error {
+		res, err = cp.client.containerPut(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // GetContainer reads NeoFS container by ID.
@@ -1629,7 +2606,14 @@ func (p *Pool) GetContainer(ctx context.Context, prm PrmContainerGet) (*containe
 		return nil, err
 	}
 
-	return cp.client.containerGet(ctx, prm)
+	var res *container.Container
+
+	err = p.execute(ctx, OperationContainerGet, cp, func(cp *clientPack) error {
+		res, err = cp.client.containerGet(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // ListContainers requests identifiers of the account-owned containers.
@@ -1639,7 +2623,14 @@ func (p *Pool) ListContainers(ctx context.Context, prm PrmContainerList) ([]cid.
 		return nil, err
 	}
 
-	return cp.client.containerList(ctx, prm)
+	var res []cid.ID
+
+	err = p.execute(ctx, OperationContainerList, cp, func(cp *clientPack) error {
+		res, err = cp.client.containerList(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // DeleteContainer sends request to remove the NeoFS container and waits for the operation to complete.
@@ -1655,7 +2646,9 @@ func (p *Pool) DeleteContainer(ctx context.Context, prm PrmContainerDelete) erro
 		return err
 	}
 
-	return cp.client.containerDelete(ctx, prm)
+	return p.execute(ctx, OperationContainerDelete, cp, func(cp *clientPack) error {
+		return cp.client.containerDelete(ctx, prm)
+	})
 }
 
 // GetEACL reads eACL table of the NeoFS container.
@@ -1665,7 +2658,14 @@ func (p *Pool) GetEACL(ctx context.Context, prm PrmContainerEACL) (*eacl.Table,
 		return nil, err
 	}
 
-	return cp.client.containerEACL(ctx, prm)
+	var res *eacl.Table
+
+	err = p.execute(ctx, OperationContainerEACL, cp, func(cp *clientPack) error {
+		res, err = cp.client.containerEACL(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // SetEACL sends request to update eACL table of the NeoFS container and waits for the operation to complete.
@@ -1681,7 +2681,9 @@ func (p *Pool) SetEACL(ctx context.Context, prm PrmContainerSetEACL) error {
 		return err
 	}
 
-	return cp.client.containerSetEACL(ctx, prm)
+	return p.execute(ctx, OperationContainerSetEACL, cp, func(cp *clientPack) error {
+		return cp.client.containerSetEACL(ctx, prm)
+	})
 }
 
 // Balance requests current balance of the NeoFS account.
@@ -1691,7 +2693,14 @@ func (p *Pool) Balance(ctx context.Context, prm PrmBalanceGet) (*accounting.Deci
 		return nil, err
 	}
 
-	return cp.client.balanceGet(ctx, prm)
+	var res *accounting.Decimal
+
+	err = p.execute(ctx, OperationBalanceGet, cp, func(cp *clientPack) error {
+		res, err = cp.client.balanceGet(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // waitForContainerPresence waits until the container is found on the NeoFS network.
@@ -1767,7 +2776,14 @@ func (p *Pool) NetworkInfo(ctx context.Context) (*netmap.NetworkInfo, error) {
 		return nil, err
 	}
 
-	return cp.client.networkInfo(ctx, prmNetworkInfo{})
+	var res *netmap.NetworkInfo
+
+	err = p.execute(ctx, OperationNetworkInfo, cp, func(cp *clientPack) error {
+		res, err = cp.client.networkInfo(ctx, prmNetworkInfo{})
+		return err
+	})
+
+	return res, err
 }
 
 // Close closes the Pool and releases all the associated resources.
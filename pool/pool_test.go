@@ -7,14 +7,19 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"io"
+	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -30,7 +35,7 @@ func TestBuildPoolClientFailed(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key:           newPrivateKey(t),
+		signer:        neofscryptotest.Signer(),
 		nodeParams:    []NodeParam{{1, "peer0", 1}},
 		clientBuilder: clientBuilder,
 	}
@@ -56,7 +61,7 @@ func TestBuildPoolCreateSessionFailed(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key:           newPrivateKey(t),
+		signer:        neofscryptotest.Signer(),
 		nodeParams:    []NodeParam{{1, "peer0", 1}},
 		clientBuilder: clientBuilder,
 	}
@@ -124,7 +129,7 @@ func TestBuildPoolOneNodeFailed(t *testing.T) {
 	log, err := zap.NewProduction()
 	require.NoError(t, err)
 	opts := InitParameters{
-		key:                     newPrivateKey(t),
+		signer:                  neofscryptotest.Signer(),
 		clientBuilder:           clientBuilder,
 		clientRebalanceInterval: 1000 * time.Millisecond,
 		logger:                  log,
@@ -145,7 +150,7 @@ func TestBuildPoolOneNodeFailed(t *testing.T) {
 		if err != nil {
 			return false
 		}
-		st, _ := clientPool.cache.Get(formCacheKey(cp.address, clientPool.key))
+		st, _ := clientPool.cache.Get(formCacheKey(cp.address, clientPool.signer))
 		return areEqualTokens(&st, expectedToken)
 	}
 	require.Never(t, condition, 900*time.Millisecond, 100*time.Millisecond)
@@ -154,7 +159,7 @@ func TestBuildPoolOneNodeFailed(t *testing.T) {
 
 func TestBuildPoolZeroNodes(t *testing.T) {
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 	}
 	_, err := NewPool(opts)
 	require.Error(t, err)
@@ -182,7 +187,7 @@ func TestOneNode(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key:           newPrivateKey(t),
+		signer:        neofscryptotest.Signer(),
 		nodeParams:    []NodeParam{{1, "peer0", 1}},
 		clientBuilder: clientBuilder,
 	}
@@ -195,7 +200,7 @@ func TestOneNode(t *testing.T) {
 
 	cp, err := pool.connection()
 	require.NoError(t, err)
-	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.key))
+	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 	require.True(t, areEqualTokens(&tok, &st))
 }
 
@@ -230,7 +235,7 @@ func TestTwoNodes(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 		nodeParams: []NodeParam{
 			{1, "peer0", 1},
 			{1, "peer1", 1},
@@ -246,7 +251,7 @@ func TestTwoNodes(t *testing.T) {
 
 	cp, err := pool.connection()
 	require.NoError(t, err)
-	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.key))
+	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 	require.True(t, containsTokens(tokens, &st))
 }
 
@@ -304,7 +309,7 @@ func TestOneOfTwoFailed(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 		nodeParams: []NodeParam{
 			{1, "peer0", 1},
 			{9, "peer1", 1},
@@ -326,7 +331,7 @@ func TestOneOfTwoFailed(t *testing.T) {
 	for i := 0; i < 5; i++ {
 		cp, err := pool.connection()
 		require.NoError(t, err)
-		st, _ := pool.cache.Get(formCacheKey(cp.address, pool.key))
+		st, _ := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 		require.True(t, areEqualTokens(tokens[0], &st))
 	}
 }
@@ -346,7 +351,7 @@ func TestTwoFailed(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 		nodeParams: []NodeParam{
 			{1, "peer0", 1},
 			{1, "peer1", 1},
@@ -394,7 +399,7 @@ func TestSessionCache(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 		nodeParams: []NodeParam{
 			{1, "peer0", 1},
 		},
@@ -414,7 +419,7 @@ func TestSessionCache(t *testing.T) {
 	// cache must contain session token
 	cp, err := pool.connection()
 	require.NoError(t, err)
-	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.key))
+	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 	require.True(t, containsTokens(tokens, &st))
 
 	var prm PrmObjectGet
@@ -427,7 +432,7 @@ func TestSessionCache(t *testing.T) {
 	// cache must not contain session token
 	cp, err = pool.connection()
 	require.NoError(t, err)
-	_, ok := pool.cache.Get(formCacheKey(cp.address, pool.key))
+	_, ok := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 	require.False(t, ok)
 
 	var prm2 PrmObjectPut
@@ -439,7 +444,7 @@ func TestSessionCache(t *testing.T) {
 	// cache must contain session token
 	cp, err = pool.connection()
 	require.NoError(t, err)
-	st, _ = pool.cache.Get(formCacheKey(cp.address, pool.key))
+	st, _ = pool.cache.Get(formCacheKey(cp.address, pool.signer))
 	require.True(t, containsTokens(tokens, &st))
 }
 
@@ -482,7 +487,7 @@ func TestPriority(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 		nodeParams: []NodeParam{
 			{1, "peer0", 1},
 			{2, "peer1", 100},
@@ -503,13 +508,13 @@ func TestPriority(t *testing.T) {
 	firstNode := func() bool {
 		cp, err := pool.connection()
 		require.NoError(t, err)
-		st, _ := pool.cache.Get(formCacheKey(cp.address, pool.key))
+		st, _ := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 		return areEqualTokens(&st, tokens[0])
 	}
 	secondNode := func() bool {
 		cp, err := pool.connection()
 		require.NoError(t, err)
-		st, _ := pool.cache.Get(formCacheKey(cp.address, pool.key))
+		st, _ := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 		return areEqualTokens(&st, tokens[1])
 	}
 	require.Never(t, secondNode, time.Second, 200*time.Millisecond)
@@ -542,7 +547,7 @@ func TestSessionCacheWithKey(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 		nodeParams: []NodeParam{
 			{1, "peer0", 1},
 		},
@@ -561,7 +566,7 @@ func TestSessionCacheWithKey(t *testing.T) {
 	// cache must contain session token
 	cp, err := pool.connection()
 	require.NoError(t, err)
-	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.key))
+	st, _ := pool.cache.Get(formCacheKey(cp.address, pool.signer))
 	require.True(t, containsTokens(tokens, &st))
 
 	var prm PrmObjectGet
@@ -595,7 +600,7 @@ func TestSessionTokenOwner(t *testing.T) {
 	}
 
 	opts := InitParameters{
-		key: newPrivateKey(t),
+		signer: neofscryptotest.Signer(),
 		nodeParams: []NodeParam{
 			{1, "peer0", 1},
 		},
@@ -630,7 +635,7 @@ func TestSessionTokenOwner(t *testing.T) {
 	err = p.openDefaultSession(&cc)
 	require.NoError(t, err)
 
-	tkn, _ := p.cache.Get(formCacheKey("peer0", anonKey))
+	tkn, _ := p.cache.Get(formCacheKey("peer0", neofsecdsa.Signer(*anonKey)))
 	require.True(t, tkn.VerifySignature())
 }
 
@@ -677,3 +682,163 @@ func TestWaitPresence(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestSharedSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	newNode := func() (*MockClient, *uuid.UUID) {
+		var id uuid.UUID
+
+		c := NewMockClient(ctrl)
+		c.EXPECT().networkInfo(gomock.Any(), gomock.Any()).Return(&netmap.NetworkInfo{}, nil).AnyTimes()
+		c.EXPECT().sessionCreate(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}) (*resCreateSession, error) {
+			id = uuid.New()
+			return &resCreateSession{
+				id:         id[:],
+				sessionKey: newBinPublicKey(t),
+			}, nil
+		}).Times(1)
+
+		return c, &id
+	}
+
+	var used []uuid.UUID
+	storeToken := func(_ context.Context, prm PrmObjectPut) {
+		require.NotNil(t, prm.stoken)
+		used = append(used, prm.stoken.ID())
+	}
+
+	nodeA, idA := newNode()
+	nodeA.EXPECT().objectPut(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, prm PrmObjectPut) (*oid.ID, error) {
+		storeToken(ctx, prm)
+		return new(oid.ID), nil
+	}).Times(2)
+
+	nodeB, idB := newNode()
+	gomock.InOrder(
+		nodeB.EXPECT().objectPut(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, prm PrmObjectPut) (*oid.ID, error) {
+			storeToken(ctx, prm)
+			return nil, fmt.Errorf("client failure: %w", apistatus.SessionTokenNotFound{})
+		}),
+		nodeB.EXPECT().objectPut(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, prm PrmObjectPut) (*oid.ID, error) {
+			storeToken(ctx, prm)
+			return new(oid.ID), nil
+		}).Times(2),
+	)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	p := &Pool{
+		cache:          cache,
+		signer:         neofscryptotest.Signer(),
+		stokenDuration: 10,
+		sharedSessions: true,
+	}
+
+	useNode := func(c client, address string) {
+		p.innerPools = []*innerPool{{
+			sampler:     newSampler([]float64{1}, rand.NewSource(0)),
+			clientPacks: []*clientPack{{client: c, healthy: true, address: address}},
+		}}
+	}
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+
+	var prm PrmObjectPut
+	prm.SetHeader(*hdr)
+
+	useNode(nodeA, "A")
+	_, err = p.PutObject(context.Background(), prm)
+	require.NoError(t, err)
+
+	// session opened on the first node is used, then reopened on the second one
+	useNode(nodeB, "B")
+	_, err = p.PutObject(context.Background(), prm)
+	require.NoError(t, err)
+
+	_, err = p.PutObject(context.Background(), prm)
+	require.NoError(t, err)
+
+	// shared session is not overwritten by the session of the second node
+	useNode(nodeA, "A")
+	_, err = p.PutObject(context.Background(), prm)
+	require.NoError(t, err)
+
+	require.Equal(t, []uuid.UUID{*idA, *idA, *idB, *idB, *idA}, used)
+}
+
+func TestSharedSessions_StreamedPayload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	payload := []byte("streamed payload")
+
+	newNode := func(id uuid.UUID, used *[]uuid.UUID) *MockClient {
+		c := NewMockClient(ctrl)
+		c.EXPECT().networkInfo(gomock.Any(), gomock.Any()).Return(&netmap.NetworkInfo{}, nil).AnyTimes()
+		c.EXPECT().sessionCreate(gomock.Any(), gomock.Any()).Return(&resCreateSession{
+			id:         id[:],
+			sessionKey: newBinPublicKey(t),
+		}, nil).Times(1)
+		c.EXPECT().objectPut(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectPut) (*oid.ID, error) {
+			require.NotNil(t, prm.stoken)
+			*used = append(*used, prm.stoken.ID())
+
+			data, err := io.ReadAll(prm.payload)
+			require.NoError(t, err)
+			require.Equal(t, payload, data)
+
+			return new(oid.ID), nil
+		}).Times(1)
+
+		return c
+	}
+
+	idA, idB := uuid.New(), uuid.New()
+
+	var usedA, usedB []uuid.UUID
+
+	nodeA := newNode(idA, &usedA)
+	nodeB := newNode(idB, &usedB)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	p := &Pool{
+		cache:          cache,
+		signer:         neofscryptotest.Signer(),
+		stokenDuration: 10,
+		sharedSessions: true,
+	}
+
+	useNode := func(c client, address string) {
+		p.innerPools = []*innerPool{{
+			sampler:     newSampler([]float64{1}, rand.NewSource(0)),
+			clientPacks: []*clientPack{{client: c, healthy: true, address: address}},
+		}}
+	}
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+
+	put := func() error {
+		var prm PrmObjectPut
+		prm.SetHeader(*hdr)
+		prm.SetPayload(bytes.NewReader(payload))
+
+		_, err := p.PutObject(context.Background(), prm)
+		return err
+	}
+
+	useNode(nodeA, "A")
+	require.NoError(t, put())
+
+	// the second node has never seen the shared session, so its own session
+	// is opened before the payload streaming
+	useNode(nodeB, "B")
+	require.NoError(t, put())
+
+	require.Equal(t, []uuid.UUID{idA}, usedA)
+	require.Equal(t, []uuid.UUID{idB}, usedB)
+}
@@ -0,0 +1,60 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+// newResumablePayload wraps the payload reader of GetObject into the reader
+// which continues reading after the stream failure by range requests from the
+// current offset. See sdkClient.ResumablePayload.
+func (p *Pool) newResumablePayload(ctx context.Context, prm PrmObjectGet, hdr object.Object, r io.ReadCloser) io.ReadCloser {
+	res := sdkClient.NewResumablePayload(ctx, hdr, r, prm.resumes, p.payloadOpener(prm))
+	res.SetResumeFilter(isNodeFailure)
+
+	return res
+}
+
+// payloadOpener returns sdkClient.PayloadOpener which reads the object header
+// and the rest of the payload through the Pool.
+func (p *Pool) payloadOpener(prm PrmObjectGet) sdkClient.PayloadOpener {
+	return func(ctx context.Context, off uint64) (object.Object, io.ReadCloser, error) {
+		if p.logger != nil {
+			p.logger.Debug("resume broken object payload stream",
+				zap.Stringer("address", prm.addr),
+				zap.Uint64("offset", off))
+		}
+
+		var prmHead PrmObjectHead
+		prmHead.prmCommon = prm.prmCommon
+		prmHead.SetAddress(prm.addr)
+
+		hdr, err := p.HeadObject(ctx, prmHead)
+		if err != nil {
+			return object.Object{}, nil, fmt.Errorf("read object header: %w", err)
+		}
+
+		size := hdr.PayloadSize()
+		if off >= size {
+			return *hdr, io.NopCloser(eofReader{}), nil
+		}
+
+		var prmRange PrmObjectRange
+		prmRange.prmCommon = prm.prmCommon
+		prmRange.SetAddress(prm.addr)
+		prmRange.SetOffset(off)
+		prmRange.SetLength(size - off)
+
+		res, err := p.ObjectRange(ctx, prmRange)
+		if err != nil {
+			return *hdr, nil, fmt.Errorf("open payload range: %w", err)
+		}
+
+		return *hdr, res, nil
+	}
+}
@@ -0,0 +1,118 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+// brokenReader returns the data and then fails.
+type brokenReader struct {
+	r   io.Reader
+	err error
+}
+
+func (x *brokenReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		err = x.err
+	}
+
+	return n, err
+}
+
+func (x *brokenReader) Close() error {
+	return nil
+}
+
+func TestPoolResumableGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	payload := make([]byte, 1000)
+	rand.Read(payload)
+
+	hdr := object.New()
+	hdr.SetID(oidtest.ID())
+	hdr.SetPayloadSize(uint64(len(payload)))
+
+	headRes := hdr
+	errBroken := errors.New("connection reset")
+
+	node := newSessionMock(t, ctrl)
+	node.EXPECT().objectGet(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, PrmObjectGet) (*ResGetObject, error) {
+		return &ResGetObject{
+			Header:  *hdr,
+			Payload: &brokenReader{r: bytes.NewReader(payload[:300]), err: errBroken},
+		}, nil
+	}).AnyTimes()
+	node.EXPECT().objectHead(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, PrmObjectHead) (*object.Object, error) {
+		return headRes, nil
+	}).AnyTimes()
+	node.EXPECT().objectRange(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, prm PrmObjectRange) (*ResObjectRange, error) {
+		require.EqualValues(t, len(payload), prm.off+prm.ln)
+
+		var r io.Reader = bytes.NewReader(payload[prm.off:])
+		if prm.off < 600 {
+			// the first range is broken too
+			r = &brokenReader{r: bytes.NewReader(payload[prm.off:600]), err: errBroken}
+		}
+
+		return &ResObjectRange{payload: rangeReaderMock{r}}, nil
+	}).AnyTimes()
+
+	p := &Pool{
+		innerPools: []*innerPool{{
+			sampler:     newSampler([]float64{1}, rand.NewSource(0)),
+			clientPacks: []*clientPack{{client: node, healthy: true, address: "node"}},
+		}},
+		cache:  cache,
+		signer: neofscryptotest.Signer(),
+	}
+
+	var prm PrmObjectGet
+	prm.SetAddress(oidtest.Address())
+
+	read := func(prm PrmObjectGet) ([]byte, error) {
+		res, err := p.GetObject(context.Background(), prm)
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(res.Payload)
+		require.NoError(t, res.Payload.Close())
+
+		return data, err
+	}
+
+	_, err = read(prm)
+	require.ErrorIs(t, err, errBroken)
+
+	prm.SetMaxResumes(1)
+
+	_, err = read(prm)
+	require.ErrorIs(t, err, errBroken)
+
+	prm.SetMaxResumes(2)
+
+	data, err := read(prm)
+	require.NoError(t, err)
+	require.Equal(t, payload, data)
+
+	headRes = object.New()
+	headRes.SetID(oidtest.ID())
+	headRes.SetPayloadSize(uint64(len(payload)))
+
+	_, err = read(prm)
+	require.ErrorIs(t, err, sdkClient.ErrObjectChanged)
+}
@@ -0,0 +1,185 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"go.uber.org/zap"
+)
+
+// RetryPolicy groups parameters of the operation retries. Operations are
+// retried on different nodes.
+//
+// By default, operations are executed once.
+//
+// See also InitParameters.SetRetryPolicy.
+type RetryPolicy struct {
+	maxAttempts int
+
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	conditions map[Operation]func(error) bool
+}
+
+// SetMaxAttempts specifies the maximum number of the operation executions
+// including the first one. Values less than 2 disable retries.
+func (x *RetryPolicy) SetMaxAttempts(n int) {
+	x.maxAttempts = n
+}
+
+// SetBackoff specifies the delay before the first retry. Each next delay is
+// twice longer, but not longer than max. Zero max means no limit.
+// By default, operations are retried immediately.
+func (x *RetryPolicy) SetBackoff(initial, max time.Duration) {
+	x.backoff = initial
+	x.maxBackoff = max
+}
+
+// SetRetryCondition specifies function which decides if the operation can be
+// retried after the given error. Overrides DefaultRetryCondition for the
+// operation.
+//
+// Note that object writing is never retried after the payload has been partly
+// streamed regardless of the condition.
+func (x *RetryPolicy) SetRetryCondition(op Operation, f func(error) bool) {
+	if x.conditions == nil {
+		x.conditions = make(map[Operation]func(error) bool)
+	}
+
+	x.conditions[op] = f
+}
+
+// DefaultRetryCondition is a retry condition used for operations without
+// custom one. Idempotent operations (see Operation.Idempotent) are retried
+// after transport failures and internal server errors, other operations
+// are not retried.
+func DefaultRetryCondition(op Operation, err error) bool {
+	return op.Idempotent() && isNodeFailure(err)
+}
+
+// isNodeFailure checks if the error is caused by the node malfunction rather
+// than by the request itself: transport failures and internal server errors.
+func isNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrLimitExceeded) {
+		return false
+	}
+
+	var st apistatus.StatusV2
+	if !errors.As(err, &st) {
+		// transport or other client-side failure
+		return true
+	}
+
+	var errInternal apistatus.ServerInternal
+	var errInternalPtr *apistatus.ServerInternal
+
+	return errors.As(err, &errInternal) || errors.As(err, &errInternalPtr)
+}
+
+// partialPutError wraps an error of the object writing which happened after
+// the payload has been partly streamed. Such writing can't be retried.
+type partialPutError struct {
+	error
+}
+
+func (x partialPutError) Unwrap() error {
+	return x.error
+}
+
+// canRetry checks if the operation failed on the given attempt can be retried.
+func (x RetryPolicy) canRetry(op Operation, attempt int, err error) bool {
+	if attempt >= x.maxAttempts {
+		return false
+	}
+
+	if errors.As(err, new(partialPutError)) {
+		return false
+	}
+
+	if f, ok := x.conditions[op]; ok {
+		return f(err)
+	}
+
+	return DefaultRetryCondition(op, err)
+}
+
+// delay returns the delay before the given retry.
+func (x RetryPolicy) delay(retry int) time.Duration {
+	d := x.backoff
+
+	for i := 1; i < retry && d > 0; i++ {
+		d *= 2
+
+		if x.maxBackoff > 0 && d >= x.maxBackoff {
+			return x.maxBackoff
+		}
+	}
+
+	if x.maxBackoff > 0 && d > x.maxBackoff {
+		return x.maxBackoff
+	}
+
+	return d
+}
+
+// execute calls f on the given node and retries it on other nodes according
+// to the Pool retry policy.
+func (p *Pool) execute(ctx context.Context, op Operation, cp *clientPack, f func(*clientPack) error) error {
+	if err := p.inflight.begin(); err != nil {
+		return err
+	}
+
+	defer p.inflight.end()
+
+	var tried map[string]struct{}
+
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		err := f(cp)
+		p.reportNodeResult(cp.address, time.Since(start), err)
+
+		if err == nil || ctx.Err() != nil || !p.retryPolicy.canRetry(op, attempt, err) {
+			return err
+		}
+
+		if tried == nil {
+			tried = make(map[string]struct{}, p.retryPolicy.maxAttempts)
+		}
+
+		tried[cp.address] = struct{}{}
+
+		if d := p.retryPolicy.delay(attempt); d > 0 {
+			t := time.NewTimer(d)
+
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return err
+			case <-t.C:
+			}
+		}
+
+		next, errConn := p.connectionExcept(tried)
+		if errConn != nil {
+			return err
+		}
+
+		if p.logger != nil {
+			p.logger.Debug("retry failed operation on another node",
+				zap.Stringer("operation", op),
+				zap.String("failed", cp.address),
+				zap.String("next", next.address),
+				zap.Error(err))
+		}
+
+		cp = next
+	}
+}
@@ -0,0 +1,90 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRetryCondition(t *testing.T) {
+	errTransport := errors.New("connection refused")
+
+	require.True(t, DefaultRetryCondition(OperationObjectHead, errTransport))
+	require.True(t, DefaultRetryCondition(OperationObjectGet, fmt.Errorf("wrapped: %w", apistatus.ServerInternal{})))
+	require.False(t, DefaultRetryCondition(OperationObjectGet, apistatus.ObjectNotFound{}))
+	require.False(t, DefaultRetryCondition(OperationContainerGet, fmt.Errorf("wrapped: %w", context.Canceled)))
+	require.False(t, DefaultRetryCondition(OperationObjectPut, errTransport))
+	require.False(t, DefaultRetryCondition(OperationContainerPut, errTransport))
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var policy RetryPolicy
+
+	errTransport := errors.New("connection refused")
+
+	require.False(t, policy.canRetry(OperationObjectHead, 1, errTransport))
+
+	policy.SetMaxAttempts(3)
+	require.True(t, policy.canRetry(OperationObjectHead, 1, errTransport))
+	require.True(t, policy.canRetry(OperationObjectHead, 2, errTransport))
+	require.False(t, policy.canRetry(OperationObjectHead, 3, errTransport))
+
+	policy.SetRetryCondition(OperationObjectPut, func(error) bool { return true })
+	require.True(t, policy.canRetry(OperationObjectPut, 1, errTransport))
+	require.False(t, policy.canRetry(OperationObjectPut, 1, fmt.Errorf("wrapped: %w", partialPutError{errTransport})))
+
+	require.Zero(t, policy.delay(1))
+
+	policy.SetBackoff(100*time.Millisecond, time.Second)
+	require.Equal(t, 100*time.Millisecond, policy.delay(1))
+	require.Equal(t, 200*time.Millisecond, policy.delay(2))
+	require.Equal(t, 800*time.Millisecond, policy.delay(4))
+	require.Equal(t, time.Second, policy.delay(5))
+	require.Equal(t, time.Second, policy.delay(10))
+}
+
+func TestPoolRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	failed := NewMockClient(ctrl)
+	failed.EXPECT().containerGet(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused")).AnyTimes()
+
+	cnr := container.New()
+
+	healthy := NewMockClient(ctrl)
+	healthy.EXPECT().containerGet(gomock.Any(), gomock.Any()).Return(cnr, nil).AnyTimes()
+
+	// sampler always selects the failed node
+	weights := []float64{1, 0}
+
+	p := &Pool{
+		innerPools: []*innerPool{{
+			sampler: newSampler(weights, rand.NewSource(0)),
+			clientPacks: []*clientPack{
+				{client: failed, healthy: true, address: "failed"},
+				{client: healthy, healthy: true, address: "healthy"},
+			},
+		}},
+	}
+
+	var prm PrmContainerGet
+	prm.SetContainerID(cidtest.ID())
+
+	_, err := p.GetContainer(context.Background(), prm)
+	require.Error(t, err)
+
+	p.retryPolicy.SetMaxAttempts(2)
+
+	res, err := p.GetContainer(context.Background(), prm)
+	require.NoError(t, err)
+	require.Equal(t, cnr, res)
+}
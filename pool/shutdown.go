@@ -0,0 +1,189 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// ErrPoolClosed is returned from the operations started after Pool.Shutdown.
+var ErrPoolClosed = errors.New("pool is closed")
+
+// inflight tracks running operations and open result streams of the Pool.
+// Zero value is ready to use.
+type inflight struct {
+	mtx sync.Mutex
+
+	closed bool
+
+	ops int
+
+	streams    map[uint64]string
+	nextStream uint64
+
+	// closed when there is nothing in flight after the shutdown
+	idle chan struct{}
+}
+
+// begin registers new operation. Returns ErrPoolClosed after the shutdown.
+func (x *inflight) begin() error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.closed {
+		return ErrPoolClosed
+	}
+
+	x.ops++
+
+	return nil
+}
+
+// end unregisters finished operation.
+func (x *inflight) end() {
+	x.mtx.Lock()
+	x.ops--
+	x.checkIdle()
+	x.mtx.Unlock()
+}
+
+// openStream registers the result stream of the running operation and returns
+// function closing it. The function also calls release if it is set, it may
+// be called several times.
+func (x *inflight) openStream(desc string, release func()) func() {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.streams == nil {
+		x.streams = make(map[uint64]string)
+	}
+
+	id := x.nextStream
+	x.nextStream++
+	x.streams[id] = desc
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			if release != nil {
+				release()
+			}
+
+			x.mtx.Lock()
+			delete(x.streams, id)
+			x.checkIdle()
+			x.mtx.Unlock()
+		})
+	}
+}
+
+// checkIdle closes idle channel if there is nothing in flight after the
+// shutdown. Must be called under the lock.
+func (x *inflight) checkIdle() {
+	if x.closed && x.ops == 0 && len(x.streams) == 0 {
+		select {
+		case <-x.idle:
+		default:
+			close(x.idle)
+		}
+	}
+}
+
+// shutdown rejects new operations and returns channel which is closed when
+// there is nothing in flight. The flag is true on the first call.
+func (x *inflight) shutdown() (<-chan struct{}, bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.closed {
+		return x.idle, false
+	}
+
+	x.closed = true
+	x.idle = make(chan struct{})
+	x.checkIdle()
+
+	return x.idle, true
+}
+
+// pending returns number of the running operations and descriptions of the
+// open streams.
+func (x *inflight) pending() (int, []string) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	streams := make([]string, 0, len(x.streams))
+	for _, desc := range x.streams {
+		streams = append(streams, desc)
+	}
+
+	sort.Strings(streams)
+
+	return x.ops, streams
+}
+
+// Shutdown gracefully closes the Pool. New operations are rejected with
+// ErrPoolClosed, while the running operations and open result streams
+// (ResGetObject.Payload, ResObjectRange and ResObjectSearch) are waited for
+// until they are finished or the context is done. After that, connections
+// to the nodes are closed.
+//
+// Returns an error listing the operations and streams left unfinished if the
+// context is done first. These streams are broken by closing the connections.
+//
+// See also Close.
+func (p *Pool) Shutdown(ctx context.Context) error {
+	idle, first := p.inflight.shutdown()
+
+	var err error
+
+	select {
+	case <-idle:
+	case <-ctx.Done():
+		ops, streams := p.inflight.pending()
+
+		if p.logger != nil {
+			for i := range streams {
+				p.logger.Warn("stream is left open on pool shutdown", zap.String("stream", streams[i]))
+			}
+		}
+
+		err = fmt.Errorf("%w: %d operations and %d streams are left unfinished", ctx.Err(), ops, len(streams))
+		if len(streams) > 0 {
+			err = fmt.Errorf("%w: %s", err, strings.Join(streams, ", "))
+		}
+	}
+
+	if !first {
+		return err
+	}
+
+	if p.cancel != nil {
+		p.cancel()
+		<-p.closedCh
+	}
+
+	p.lock.RLock()
+	innerPools := p.innerPools
+	p.lock.RUnlock()
+
+	for _, inner := range innerPools {
+		inner.lock.RLock()
+		for _, cp := range inner.clientPacks {
+			if errClose := cp.client.close(); errClose != nil && p.logger != nil {
+				p.logger.Warn("failed to close connection on pool shutdown",
+					zap.String("address", cp.address),
+					zap.Error(errClose))
+			}
+		}
+		inner.lock.RUnlock()
+	}
+
+	return err
+}
@@ -0,0 +1,122 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInflight(t *testing.T) {
+	var x inflight
+
+	require.NoError(t, x.begin())
+
+	released := 0
+	closeStream := x.openStream("stream", func() { released++ })
+
+	x.end()
+
+	idle, first := x.shutdown()
+	require.True(t, first)
+	require.ErrorIs(t, x.begin(), ErrPoolClosed)
+
+	ops, streams := x.pending()
+	require.Zero(t, ops)
+	require.Equal(t, []string{"stream"}, streams)
+
+	select {
+	case <-idle:
+		t.Fatal("shutdown finished with the open stream")
+	default:
+	}
+
+	closeStream()
+	closeStream()
+	require.Equal(t, 1, released)
+
+	<-idle
+
+	_, first = x.shutdown()
+	require.False(t, first)
+}
+
+func TestPoolShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	newPool := func() *Pool {
+		cache, err := newCache()
+		require.NoError(t, err)
+
+		node := newSessionMock(t, ctrl)
+		node.EXPECT().objectGet(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, PrmObjectGet) (*ResGetObject, error) {
+			return &ResGetObject{Payload: io.NopCloser(bytes.NewReader([]byte("payload")))}, nil
+		}).AnyTimes()
+		node.EXPECT().close().Return(nil).Times(1)
+
+		return &Pool{
+			innerPools: []*innerPool{{
+				sampler:     newSampler([]float64{1}, rand.NewSource(0)),
+				clientPacks: []*clientPack{{client: node, healthy: true, address: "node"}},
+			}},
+			cache:  cache,
+			signer: neofscryptotest.Signer(),
+		}
+	}
+
+	var prm PrmObjectGet
+	prm.SetAddress(oidtest.Address())
+
+	t.Run("graceful", func(t *testing.T) {
+		p := newPool()
+
+		res, err := p.GetObject(context.Background(), prm)
+		require.NoError(t, err)
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+
+			_, _ = io.ReadAll(res.Payload)
+			_ = res.Payload.Close()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		require.NoError(t, p.Shutdown(ctx))
+
+		_, err = p.GetObject(context.Background(), prm)
+		require.ErrorIs(t, err, ErrPoolClosed)
+
+		var prmCnr PrmContainerGet
+		prmCnr.SetContainerID(cidtest.ID())
+
+		_, err = p.GetContainer(context.Background(), prmCnr)
+		require.ErrorIs(t, err, ErrPoolClosed)
+
+		// connections are closed once
+		require.NoError(t, p.Shutdown(ctx))
+	})
+
+	t.Run("leaked stream", func(t *testing.T) {
+		p := newPool()
+
+		_, err := p.GetObject(context.Background(), prm)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err = p.Shutdown(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Contains(t, err.Error(), "ObjectGet on node")
+	})
+}
@@ -0,0 +1,231 @@
+package pool
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// StatisticCollector collects results of the operations executed by Pool on
+// the nodes, e.g. to export them to the monitoring system.
+//
+// See also InitParameters.SetStatisticCollector.
+type StatisticCollector interface {
+	// CollectOperation is called after each operation executed on the node
+	// with the given address. Error is nil if the operation succeeded.
+	//
+	// Must be safe for concurrent use.
+	CollectOperation(address string, op Operation, latency time.Duration, err error)
+}
+
+// latencyBuckets are upper bounds of the latency histogram buckets.
+var latencyBuckets = [...]time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// LatencyHistogram represents distribution of the operation latencies.
+type LatencyHistogram struct {
+	// the last bucket is unbounded
+	counts [len(latencyBuckets) + 1]uint64
+	sum    time.Duration
+}
+
+// Bounds returns upper bounds (inclusive) of the histogram buckets. The last
+// bucket returned by Counts has no upper bound, so it is not included.
+func (x LatencyHistogram) Bounds() []time.Duration {
+	return append([]time.Duration(nil), latencyBuckets[:]...)
+}
+
+// Counts returns number of the operations in each histogram bucket. Result
+// is one element longer than Bounds: the last element is a number of the
+// operations which took longer than the last bound.
+func (x LatencyHistogram) Counts() []uint64 {
+	return append([]uint64(nil), x.counts[:]...)
+}
+
+// Sum returns total duration of all operations.
+func (x LatencyHistogram) Sum() time.Duration {
+	return x.sum
+}
+
+func (x *LatencyHistogram) observe(d time.Duration) {
+	i := 0
+	for i < len(latencyBuckets) && d > latencyBuckets[i] {
+		i++
+	}
+
+	x.counts[i]++
+	x.sum += d
+}
+
+// OperationStatistic groups statistics of the particular operation executed
+// on the node.
+type OperationStatistic struct {
+	requests uint64
+	errors   uint64
+	latency  LatencyHistogram
+}
+
+// Requests returns total number of the executed operations.
+func (x OperationStatistic) Requests() uint64 {
+	return x.requests
+}
+
+// Errors returns number of the failed operations.
+func (x OperationStatistic) Errors() uint64 {
+	return x.errors
+}
+
+// Latency returns distribution of the operation latencies.
+func (x OperationStatistic) Latency() LatencyHistogram {
+	return x.latency
+}
+
+// NodeStatistic groups statistics of the single Pool node.
+type NodeStatistic struct {
+	address string
+	healthy bool
+	weight  float64
+	ops     [operationLast]OperationStatistic
+}
+
+// Address returns address of the node.
+func (x NodeStatistic) Address() string {
+	return x.address
+}
+
+// Healthy returns current health status of the node.
+func (x NodeStatistic) Healthy() bool {
+	return x.healthy
+}
+
+// Weight returns current probability of the node selection within its
+// priority group. Unhealthy nodes may have zero weight.
+func (x NodeStatistic) Weight() float64 {
+	return x.weight
+}
+
+// Operation returns statistics of the given operation.
+func (x NodeStatistic) Operation(op Operation) OperationStatistic {
+	if op < operationLast {
+		return x.ops[op]
+	}
+
+	return OperationStatistic{}
+}
+
+// Requests returns total number of the operations executed on the node.
+func (x NodeStatistic) Requests() uint64 {
+	var res uint64
+	for i := range x.ops {
+		res += x.ops[i].requests
+	}
+
+	return res
+}
+
+// Errors returns total number of the operations failed on the node.
+func (x NodeStatistic) Errors() uint64 {
+	var res uint64
+	for i := range x.ops {
+		res += x.ops[i].errors
+	}
+
+	return res
+}
+
+// Statistic is a snapshot of the Pool statistics.
+//
+// See also Pool.Statistic.
+type Statistic struct {
+	nodes []NodeStatistic
+}
+
+// Nodes returns statistics of all Pool nodes.
+func (x Statistic) Nodes() []NodeStatistic {
+	return x.nodes
+}
+
+// Node returns statistics of the node with the given address.
+func (x Statistic) Node(address string) (*NodeStatistic, error) {
+	for i := range x.nodes {
+		if x.nodes[i].address == address {
+			return &x.nodes[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("node %s not found", address)
+}
+
+// nodeStat accumulates statistics of the operations executed on the node.
+type nodeStat struct {
+	mtx sync.Mutex
+	ops [operationLast]OperationStatistic
+}
+
+func (x *nodeStat) record(op Operation, latency time.Duration, err error) {
+	if op >= operationLast {
+		return
+	}
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.ops[op].requests++
+	if err != nil {
+		x.ops[op].errors++
+	}
+
+	x.ops[op].latency.observe(latency)
+}
+
+// writeTo copies accumulated statistics to the snapshot.
+func (x *nodeStat) writeTo(dst *NodeStatistic) {
+	x.mtx.Lock()
+	dst.ops = x.ops
+	x.mtx.Unlock()
+}
+
+// Statistic returns snapshot of the statistics of the nodes which currently
+// form the Pool. Statistics of the removed nodes are not returned.
+func (p *Pool) Statistic() Statistic {
+	p.lock.RLock()
+	innerPools := p.innerPools
+	p.lock.RUnlock()
+
+	var res Statistic
+
+	for _, inner := range innerPools {
+		inner.lock.RLock()
+		for i, cp := range inner.clientPacks {
+			node := NodeStatistic{
+				address: cp.address,
+				healthy: cp.healthy,
+			}
+
+			if i < len(inner.weights) {
+				node.weight = inner.weights[i]
+			}
+
+			if stat := cp.client.statistic(); stat != nil {
+				stat.writeTo(&node)
+			}
+
+			res.nodes = append(res.nodes, node)
+		}
+		inner.lock.RUnlock()
+	}
+
+	return res
+}
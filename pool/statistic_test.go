@@ -0,0 +1,98 @@
+package pool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type collectedOperation struct {
+	address string
+	op      Operation
+	failed  bool
+}
+
+type collectorMock struct {
+	ops []collectedOperation
+}
+
+func (x *collectorMock) CollectOperation(address string, op Operation, _ time.Duration, err error) {
+	x.ops = append(x.ops, collectedOperation{address: address, op: op, failed: err != nil})
+}
+
+func TestLatencyHistogram(t *testing.T) {
+	var h LatencyHistogram
+
+	h.observe(0)
+	h.observe(time.Millisecond)
+	h.observe(7 * time.Millisecond)
+	h.observe(time.Minute)
+
+	counts := h.Counts()
+	require.Len(t, counts, len(h.Bounds())+1)
+	require.EqualValues(t, 2, counts[0])
+	require.EqualValues(t, 1, counts[2])
+	require.EqualValues(t, 1, counts[len(counts)-1])
+	require.Equal(t, time.Minute+8*time.Millisecond, h.Sum())
+}
+
+func TestPoolStatistic(t *testing.T) {
+	collector := new(collectorMock)
+
+	newNode := func(address string) *clientMock {
+		return &clientMock{clientWrapper: clientWrapper{
+			address:   address,
+			stat:      new(nodeStat),
+			collector: collector,
+		}}
+	}
+
+	node0, node1 := newNode("node0"), newNode("node1")
+
+	p := &Pool{
+		innerPools: []*innerPool{{
+			clientPacks: []*clientPack{
+				{client: node0, healthy: true, address: "node0"},
+				{client: node1, healthy: false, address: "node1"},
+			},
+			weights: []float64{1, 0},
+		}},
+	}
+
+	node0.incRequests(OperationObjectGet, time.Millisecond, nil)
+	node0.incRequests(OperationObjectGet, time.Second, errors.New("any error"))
+	node0.incRequests(OperationObjectPut, time.Millisecond, nil)
+	node1.incRequests(OperationEndpointInfo, time.Millisecond, errors.New("any error"))
+
+	stat := p.Statistic()
+	require.Len(t, stat.Nodes(), 2)
+
+	st0, err := stat.Node("node0")
+	require.NoError(t, err)
+	require.True(t, st0.Healthy())
+	require.EqualValues(t, 1, st0.Weight())
+	require.EqualValues(t, 3, st0.Requests())
+	require.EqualValues(t, 1, st0.Errors())
+	require.EqualValues(t, 2, st0.Operation(OperationObjectGet).Requests())
+	require.EqualValues(t, 1, st0.Operation(OperationObjectGet).Errors())
+	require.Equal(t, time.Second+time.Millisecond, st0.Operation(OperationObjectGet).Latency().Sum())
+	require.Zero(t, st0.Operation(OperationObjectHead).Requests())
+
+	st1, err := stat.Node("node1")
+	require.NoError(t, err)
+	require.False(t, st1.Healthy())
+	require.Zero(t, st1.Weight())
+	require.EqualValues(t, 1, st1.Errors())
+
+	_, err = stat.Node("node2")
+	require.Error(t, err)
+
+	require.Equal(t, []collectedOperation{
+		{address: "node0", op: OperationObjectGet},
+		{address: "node0", op: OperationObjectGet, failed: true},
+		{address: "node0", op: OperationObjectPut},
+		{address: "node1", op: OperationEndpointInfo, failed: true},
+	}, collector.ops)
+}
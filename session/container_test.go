@@ -9,6 +9,7 @@ import (
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -276,7 +277,7 @@ func TestContainerSignature(t *testing.T) {
 	for i := 0; i < len(fs); i += 2 {
 		fs[i]()
 
-		require.NoError(t, x.Sign(signer))
+		require.NoError(t, x.Sign(neofsecdsa.Signer(signer)))
 		require.True(t, x.VerifySignature())
 
 		fs[i+1]()
@@ -285,6 +286,9 @@ func TestContainerSignature(t *testing.T) {
 		fs[i]()
 		require.True(t, x.VerifySignature())
 	}
+
+	require.NoError(t, x.Sign(neofsecdsa.SignerWalletConnect(signer)))
+	require.True(t, x.VerifySignature())
 }
 
 func TestIssuedBy(t *testing.T) {
@@ -298,7 +302,7 @@ func TestIssuedBy(t *testing.T) {
 
 	require.False(t, session.IssuedBy(token, issuer))
 
-	require.NoError(t, token.Sign(signer))
+	require.NoError(t, token.Sign(neofsecdsa.Signer(signer)))
 	require.True(t, session.IssuedBy(token, issuer))
 }
 
@@ -308,7 +312,7 @@ func TestContainer_Issuer(t *testing.T) {
 
 	require.Zero(t, token.Issuer())
 
-	require.NoError(t, token.Sign(signer))
+	require.NoError(t, token.Sign(neofsecdsa.Signer(signer)))
 
 	var issuer user.ID
 
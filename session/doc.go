@@ -15,7 +15,7 @@ signing the public part of the secret (public session key).
 	tok.SetAuthKey(trustedKey)
 	// ...
 
-	err := tok.Sign(principalKey)
+	err := tok.Sign(principalSigner)
 	// ...
 
 	// transfer the token to a trusted party
@@ -2,7 +2,6 @@ package session
 
 import (
 	"bytes"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 
@@ -11,7 +10,6 @@ import (
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
-	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
@@ -162,8 +160,9 @@ func (x *Object) UnmarshalJSON(data []byte) error {
 	return x.ReadFromV2(m)
 }
 
-// Sign calculates and writes signature of the Object data.
-// Returns signature calculation errors.
+// Sign calculates and writes signature of the Object data using the signer.
+// The signer's public key becomes the issuer of the session, so it must be an
+// ECDSA key (see user.IDFromSigner). Returns signature calculation errors.
 //
 // Zero Object is unsigned.
 //
@@ -171,9 +170,13 @@ func (x *Object) UnmarshalJSON(data []byte) error {
 // expected to be calculated as a final stage of Object formation.
 //
 // See also VerifySignature.
-func (x *Object) Sign(key ecdsa.PrivateKey) error {
+func (x *Object) Sign(signer neofscrypto.Signer) error {
 	var idUser user.ID
-	user.IDFromKey(&idUser, key.PublicKey)
+
+	err := user.IDFromSigner(&idUser, signer)
+	if err != nil {
+		return fmt.Errorf("issuer from the signer: %w", err)
+	}
 
 	var idUserV2 refs.OwnerID
 	idUser.WriteToV2(&idUserV2)
@@ -189,7 +192,7 @@ func (x *Object) Sign(key ecdsa.PrivateKey) error {
 		panic(fmt.Sprintf("unexpected error from Token.StableMarshal: %v", err))
 	}
 
-	return x.sig.Calculate(neofsecdsa.Signer(key), data)
+	return x.sig.Calculate(signer, data)
 }
 
 // VerifySignature checks if Object signature is presented and valid.
@@ -318,7 +318,7 @@ func TestObjectSignature(t *testing.T) {
 	for i := 0; i < len(fs); i += 2 {
 		fs[i]()
 
-		require.NoError(t, x.Sign(signer))
+		require.NoError(t, x.Sign(neofsecdsa.Signer(signer)))
 		require.True(t, x.VerifySignature())
 
 		fs[i+1]()
@@ -327,6 +327,9 @@ func TestObjectSignature(t *testing.T) {
 		fs[i]()
 		require.True(t, x.VerifySignature())
 	}
+
+	require.NoError(t, x.Sign(neofsecdsa.SignerWalletConnect(signer)))
+	require.True(t, x.VerifySignature())
 }
 
 func TestObject_Issuer(t *testing.T) {
@@ -335,7 +338,7 @@ func TestObject_Issuer(t *testing.T) {
 
 	require.Zero(t, token.Issuer())
 
-	require.NoError(t, token.Sign(signer))
+	require.NoError(t, token.Sign(neofsecdsa.Signer(signer)))
 
 	var issuer user.ID
 
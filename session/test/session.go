@@ -6,23 +6,14 @@ import (
 	"crypto/rand"
 
 	"github.com/google/uuid"
-	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 )
 
-var p ecdsa.PrivateKey
-
-func init() {
-	k, err := keys.NewPrivateKey()
-	if err != nil {
-		panic(err)
-	}
-
-	p = k.PrivateKey
-}
+var signer = neofscryptotest.Signer()
 
 // Container returns random session.Container.
 //
@@ -52,7 +43,7 @@ func Container() *session.Container {
 func ContainerSigned() *session.Container {
 	tok := Container()
 
-	err := tok.Sign(p)
+	err := tok.Sign(signer)
 	if err != nil {
 		panic(err)
 	}
@@ -91,7 +82,7 @@ func Object() *session.Object {
 func ObjectSigned() *session.Object {
 	tok := Object()
 
-	err := tok.Sign(p)
+	err := tok.Sign(signer)
 	if err != nil {
 		panic(err)
 	}
@@ -2,11 +2,33 @@ package user
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"fmt"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 )
 
 // IDFromKey forms the ID using script hash calculated for the given key.
 func IDFromKey(id *ID, key ecdsa.PublicKey) {
 	id.SetScriptHash((*keys.PublicKey)(&key).GetScriptHash())
 }
+
+// IDFromSigner forms the ID using script hash calculated for the public key
+// of the given signer. Returns an error if the public key is not a compressed
+// ECDSA public key (see neofsecdsa.PublicKey).
+func IDFromSigner(id *ID, signer neofscrypto.Signer) error {
+	pub := signer.Public()
+
+	data := make([]byte, pub.MaxEncodedSize())
+	data = data[:pub.Encode(data)]
+
+	key, err := keys.NewPublicKeyFromBytes(data, elliptic.P256())
+	if err != nil {
+		return fmt.Errorf("decode public key: %w", err)
+	}
+
+	id.SetScriptHash(key.GetScriptHash())
+
+	return nil
+}
@@ -6,6 +6,9 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/stretchr/testify/require"
 )
@@ -22,3 +25,20 @@ func TestIDFromKey(t *testing.T) {
 
 	require.Equal(t, "NNLi44dJNXtDNSBkofB48aTVYtb1zZrNEs", id.EncodeToString())
 }
+
+func TestIDFromSigner(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var id, expected user.ID
+
+	user.IDFromKey(&expected, k.PrivateKey.PublicKey)
+
+	for _, signer := range []neofscrypto.Signer{
+		neofsecdsa.Signer(k.PrivateKey),
+		neofsecdsa.SignerRFC6979(k.PrivateKey),
+	} {
+		require.NoError(t, user.IDFromSigner(&id, signer))
+		require.True(t, expected.Equals(id))
+	}
+}